@@ -0,0 +1,27 @@
+package rt
+
+// BlendedPattern mixes two patterns together, Weight of 0 is all A and 1
+// is all B
+type BlendedPattern struct {
+	BasePattern
+	A      Pattern
+	B      Pattern
+	Weight float64
+}
+
+func NewBlendedPattern(a, b Pattern) *BlendedPattern {
+	return &BlendedPattern{
+		BasePattern: NewBasePattern(),
+		A:           a,
+		B:           b,
+		Weight:      0.5,
+	}
+}
+
+func (b *BlendedPattern) LocalPatternAt(p *Point) *Color {
+	ca := PatternAt(b.A, p).Multi(1 - b.Weight)
+	cb := PatternAt(b.B, p).Multi(b.Weight)
+	c := ca.Add(cb)
+	c.W = 1
+	return c
+}
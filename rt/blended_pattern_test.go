@@ -0,0 +1,33 @@
+package rt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Blending two patterns averages their colors at each point
+func TestBlendedPattern(t *testing.T) {
+	a := NewStripePattern(NewColor(1, 0, 0, 1), NewColor(0, 0, 0, 1))
+	b := NewStripePattern(NewColor(0, 0, 1, 1), NewColor(0, 0, 0, 1))
+	b.SetTransform(NewTransform().RotateY(math.Pi / 2))
+	p := NewBlendedPattern(a, b)
+
+	assert.Equal(t, 0.5, p.Weight)
+	assert.True(t, NewColor(0.5, 0, 0.5, 1).Equals(p.LocalPatternAt(NewPoint(0.5, 0, -0.5))))
+	assert.True(t, NewColor(0, 0, 0.5, 1).Equals(p.LocalPatternAt(NewPoint(1.5, 0, -0.5))))
+	assert.True(t, NewColor(0.5, 0, 0, 1).Equals(p.LocalPatternAt(NewPoint(0.5, 0, 0.5))))
+	assert.True(t, NewColor(0, 0, 0, 1).Equals(p.LocalPatternAt(NewPoint(1.5, 0, 0.5))))
+}
+
+// The weight controls how much of each pattern ends up in the blend
+func TestBlendedPatternWeight(t *testing.T) {
+	p := NewBlendedPattern(NewSolidPattern(NewColor(1, 0, 0, 1)), NewSolidPattern(NewColor(0, 0, 1, 1)))
+
+	p.Weight = 0
+	assert.True(t, NewColor(1, 0, 0, 1).Equals(p.LocalPatternAt(NewPoint(0, 0, 0))))
+
+	p.Weight = 0.25
+	assert.True(t, NewColor(0.75, 0, 0.25, 1).Equals(p.LocalPatternAt(NewPoint(0, 0, 0))))
+}
@@ -0,0 +1,82 @@
+package rt
+
+import "math"
+
+// Camera maps the canvas one unit in front of the eye. Like shapes it
+// caches the inverse of its transform, so use SetTransform to change it.
+type Camera struct {
+	HSize       int
+	VSize       int
+	FieldOfView float64
+	PixelSize   float64
+	halfWidth   float64
+	halfHeight  float64
+	transform   *Transform
+	inverse     *Transform
+}
+
+func NewCamera(hsize, vsize int, fov float64) *Camera {
+	c := Camera{
+		HSize:       hsize,
+		VSize:       vsize,
+		FieldOfView: fov,
+	}
+
+	halfView := math.Tan(fov / 2)
+	aspect := float64(hsize) / float64(vsize)
+
+	if aspect >= 1 {
+		c.halfWidth = halfView
+		c.halfHeight = halfView / aspect
+	} else {
+		c.halfWidth = halfView * aspect
+		c.halfHeight = halfView
+	}
+
+	c.PixelSize = (c.halfWidth * 2) / float64(hsize)
+	c.SetTransform(NewTransform())
+
+	return &c
+}
+
+func (c *Camera) Transform() *Transform {
+	return c.transform
+}
+
+func (c *Camera) SetTransform(t *Transform) {
+	c.transform = t
+	c.inverse = t.Invert().(*Transform)
+}
+
+// RayForPixel returns the ray from the camera through the center of the pixel
+func (c *Camera) RayForPixel(px, py int) *Ray {
+
+	// Offset from the edge of the canvas to the pixel's center
+	xoffset := (float64(px) + 0.5) * c.PixelSize
+	yoffset := (float64(py) + 0.5) * c.PixelSize
+
+	// Untransformed coordinates of the pixel in world space,
+	// the camera looks toward -z, so +x is to the left
+	wx := c.halfWidth - xoffset
+	wy := c.halfHeight - yoffset
+
+	pixel := c.inverse.TMulti(NewPoint(wx, wy, -1))
+	origin := c.inverse.TMulti(NewPoint(0, 0, 0))
+
+	dir := pixel.Sub(origin)
+	dir.W = 0
+
+	return NewRay(origin, dir.Norm())
+}
+
+func (c *Camera) Render(w *World) *Canvas {
+	img := NewCanvas(c.HSize, c.VSize)
+
+	for y := 0; y < c.VSize; y++ {
+		for x := 0; x < c.HSize; x++ {
+			img.Set(x, y, w.ColorAt(c.RayForPixel(x, y), w.MaxDepth))
+		}
+	}
+
+	return img
+}
@@ -0,0 +1,109 @@
+package rt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: Constructing a camera
+// Given hsize ← 160
+// And vsize ← 120
+// And field_of_view ← π/2
+// When c ← camera(hsize, vsize, field_of_view)
+// Then c.hsize = 160
+// And c.vsize = 120
+// And c.field_of_view = π/2
+// And c.transform = identity_matrix
+func TestCameraNew(t *testing.T) {
+	c := NewCamera(160, 120, math.Pi/2)
+
+	assert.Equal(t, 160, c.HSize)
+	assert.Equal(t, 120, c.VSize)
+	assert.Equal(t, math.Pi/2, c.FieldOfView)
+	assert.True(t, c.Transform().Equal(m4i))
+}
+
+// Scenario: The pixel size for a horizontal canvas
+// Given c ← camera(200, 125, π/2)
+// Then c.pixel_size = 0.01
+
+// Scenario: The pixel size for a vertical canvas
+// Given c ← camera(125, 200, π/2)
+// Then c.pixel_size = 0.01
+func TestCameraPixelSize(t *testing.T) {
+	c1 := NewCamera(200, 125, math.Pi/2)
+	c2 := NewCamera(125, 200, math.Pi/2)
+
+	assert.True(t, Equal(0.01, c1.PixelSize))
+	assert.True(t, Equal(0.01, c2.PixelSize))
+}
+
+// Scenario: Constructing a ray through the center of the canvas
+// Given c ← camera(201, 101, π/2)
+// When r ← ray_for_pixel(c, 100, 50)
+// Then r.origin = point(0, 0, 0)
+// And r.direction = vector(0, 0, -1)
+func TestCameraRayForPixelCenter(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2)
+
+	r := c.RayForPixel(100, 50)
+
+	assert.True(t, NewPoint(0, 0, 0).Equals(r.Origin))
+	assert.True(t, NewVector(0, 0, -1).Equals(r.Direction))
+}
+
+// Scenario: Constructing a ray through a corner of the canvas
+// Given c ← camera(201, 101, π/2)
+// When r ← ray_for_pixel(c, 0, 0)
+// Then r.origin = point(0, 0, 0)
+// And r.direction = vector(0.66519, 0.33259, -0.66851)
+func TestCameraRayForPixelCorner(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2)
+
+	r := c.RayForPixel(0, 0)
+
+	assert.True(t, NewPoint(0, 0, 0).Equals(r.Origin))
+	assert.InDelta(t, 0.66519, r.Direction.X, 0.00001)
+	assert.InDelta(t, 0.33259, r.Direction.Y, 0.00001)
+	assert.InDelta(t, -0.66851, r.Direction.Z, 0.00001)
+}
+
+// Scenario: Constructing a ray when the camera is transformed
+// Given c ← camera(201, 101, π/2)
+// When c.transform ← rotation_y(π/4) * translation(0, -2, 5)
+// And r ← ray_for_pixel(c, 100, 50)
+// Then r.origin = point(0, 2, -5)
+// And r.direction = vector(√2/2, 0, -√2/2)
+func TestCameraRayForPixelTransformed(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2)
+	c.SetTransform(NewTransform().RotateY(math.Pi/4).Translate(0, -2, 5))
+
+	r := c.RayForPixel(100, 50)
+
+	assert.True(t, NewPoint(0, 2, -5).Equals(r.Origin))
+	assert.True(t, NewVector(math.Sqrt2/2, 0, -math.Sqrt2/2).Equals(r.Direction))
+}
+
+// Scenario: Rendering a world with a camera
+// Given w ← default_world()
+// And c ← camera(11, 11, π/2)
+// And from ← point(0, 0, -5)
+// And to ← point(0, 0, 0)
+// And up ← vector(0, 1, 0)
+// And c.transform ← view_transform(from, to, up)
+// When image ← render(c, w)
+// Then pixel_at(image, 5, 5) = color(0.38066, 0.47583, 0.2855)
+func TestCameraRender(t *testing.T) {
+	w := newDefaultWorld()
+	c := NewCamera(11, 11, math.Pi/2)
+	c.SetTransform(ViewTransform(NewPoint(0, 0, -5), NewPoint(0, 0, 0), NewVector(0, 1, 0)))
+
+	img := c.Render(w)
+	p := img.Get(5, 5)
+
+	assert.InDelta(t, 0.38066, p.X, 0.00001)
+	assert.InDelta(t, 0.47583, p.Y, 0.00001)
+	assert.InDelta(t, 0.2855, p.Z, 0.00001)
+}
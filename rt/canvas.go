@@ -1,10 +1,12 @@
 package rt
 
 import (
+	"bufio"
 	"fmt"
 	"image"
 	"image/color"
 	"image/png"
+	"io"
 	"os"
 	"strings"
 )
@@ -43,6 +45,60 @@ func (ca *Canvas) Get(x, y int) *Color {
 	return ca.Data[idx]
 }
 
+// CanvasFromImage copies an image into a canvas, scaling each channel into
+// 0 to 1. Transparency is dropped without darkening the colors, canvases
+// are always opaque.
+func CanvasFromImage(img image.Image) *Canvas {
+	b := img.Bounds()
+	c := NewCanvas(b.Dx(), b.Dy())
+
+	for y := 0; y < c.Height; y++ {
+		for x := 0; x < c.Width; x++ {
+			p := color.NRGBA64Model.Convert(img.At(b.Min.X+x, b.Min.Y+y)).(color.NRGBA64)
+			c.Set(x, y, NewColor(float64(p.R)/0xffff, float64(p.G)/0xffff, float64(p.B)/0xffff, 1))
+		}
+	}
+
+	return c
+}
+
+// CanvasFromPNG reads a PNG image into a canvas
+func CanvasFromPNG(r io.Reader) (*Canvas, error) {
+	img, err := png.Decode(r)
+	if err != nil {
+		return nil, err
+	}
+	return CanvasFromImage(img), nil
+}
+
+// LoadCanvasFile reads a PPM or PNG image into a canvas, telling them
+// apart by the start of the file rather than its extension
+func LoadCanvasFile(filename string) (*Canvas, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	br := bufio.NewReader(f)
+	magic, err := br.Peek(2)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	var c *Canvas
+	if magic[0] == 'P' {
+		c, err = CanvasFromPPM(br)
+	} else {
+		c, err = CanvasFromPNG(br)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return c, nil
+}
+
 func (ca *Canvas) ToPNG(filename string) {
 
 	f, err := os.Create(filename)
@@ -70,96 +126,10 @@ func (ca *Canvas) ToPNG(filename string) {
 	png.Encode(f, img)
 }
 
-// I am dumb and this could be better I'm sure.
+// ToPPM returns the canvas as a plain text P3 PPM, use WritePPM to stream
+// large canvases or write the smaller binary P6
 func (ca *Canvas) ToPPM() string {
-
 	var buf strings.Builder
-	// Build PPM Header
-	buf.WriteString("P3\n")
-	buf.WriteString(fmt.Sprintf("%d %d\n", ca.Width, ca.Height))
-	buf.WriteString("255\n")
-
-	// Build PPM Data
-	clw := 0 // current line width. For compatability each line can only be 70 chars
-
-	e := NewColor(0, 0, 0, 0).ToRGB255String() // Empty color for missing data
-
-	for p := 0; p < len(ca.Data); p++ {
-
-		d := ca.Data[p]
-
-		var s string
-
-		if d == nil {
-			s = e
-		} else {
-			s = d.ToRGB255String()
-		}
-
-		// Check for eol, that is are we at the end of a row
-		eol := (p > 0 && (p+1)%(ca.Width) == 0)
-
-		// Are we over PPM_MAX_CHARS
-		if clw+len(s) > PPM_MAX_CHARS {
-			pts := strings.Split(s, " ")
-			for i, p := range pts {
-				l := clw + len(p)
-
-				var np string
-				var npls = 0
-
-				if i+1 < len(pts) {
-					np = pts[i+1]
-					npls = l + len(np) // next part length with space
-				} else {
-					np = ""
-				}
-
-				// If adding this part is equal or over max chars
-				if l >= PPM_MAX_CHARS {
-					buf.WriteString("\n")
-					buf.WriteString(p + " ")
-					clw = len(p) + 1
-
-					// Look ahead and check if we can fix the next chunk
-					// if not new line and print with a space
-				} else if np != "" && npls == PPM_MAX_CHARS {
-					buf.WriteString(p)
-					buf.WriteString("\n")
-					clw = 0
-				} else if np != "" && npls > PPM_MAX_CHARS {
-					buf.WriteString("\n")
-					buf.WriteString(p + " ")
-					clw = len(p) + 1
-
-				} else {
-					if eol {
-						buf.WriteString(p)
-						clw = 0
-					} else {
-						buf.WriteString(p + " ")
-						clw += len(p) + 1
-					}
-				}
-			}
-
-			s = "" // set the input string to empty so we can handle eol
-		}
-
-		if eol && s == "" {
-			buf.WriteString("\n")
-			clw = 0
-		} else if eol && s != "" {
-			buf.WriteString(s)
-			buf.WriteString("\n")
-			clw = 0
-		} else {
-			if s != "" {
-				buf.WriteString(s + " ")
-				clw += len(s) + 1
-			}
-		}
-	}
-
+	ca.WritePPM(&buf, PPM_P3)
 	return buf.String()
 }
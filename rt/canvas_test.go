@@ -2,6 +2,13 @@ package rt
 
 import (
 	"bufio"
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -117,7 +124,6 @@ func TestCanvasPPMNewLineAfterRow(t *testing.T) {
 // 		153 255 204 153 255 204 153 255 204 153 255 204 15
 
 func TestCanvasPPMLineWidth70Chars(t *testing.T) {
-	t.Skip()
 	ca := NewCanvas(10, 2)
 
 	// Create an cavas with data
@@ -132,8 +138,6 @@ func TestCanvasPPMLineWidth70Chars(t *testing.T) {
 	// Convert to PPM
 	output := ca.ToPPM()
 
-	WriteFile("ppm_test.ppm", output)
-
 	// Read PPM String to ensure it conforms
 	s := bufio.NewScanner(strings.NewReader(output))
 	line := 0
@@ -158,3 +162,71 @@ func TestCanvasPPMLineWidth70Chars(t *testing.T) {
 }
 
 //assert.LessOrEqual(t, len(s.Text()), 70)
+
+// PNGs are read with each channel scaled into 0 to 1
+func TestCanvasFromPNG(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.RGBA{255, 0, 51, 255})
+	img.Set(1, 0, color.RGBA{0, 255, 102, 255})
+
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+
+	c, err := CanvasFromPNG(&buf)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, c.Width)
+	assert.Equal(t, 1, c.Height)
+	assert.True(t, NewColor(1, 0, 0.2, 1).Equals(c.Get(0, 0)))
+	assert.True(t, NewColor(0, 1, 0.4, 1).Equals(c.Get(1, 0)))
+}
+
+// Semi transparent pixels keep their full color
+func TestCanvasFromPNGAlpha(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.NRGBA{255, 0, 51, 128})
+	img.Set(1, 0, color.NRGBA{0, 255, 102, 64})
+
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+
+	c, err := CanvasFromPNG(&buf)
+	assert.NoError(t, err)
+
+	// Undoing the premultiply loses a little precision
+	for x, expected := range []*Color{NewColor(1, 0, 0.2, 1), NewColor(0, 1, 0.4, 1)} {
+		got := c.Get(x, 0)
+		assert.InDelta(t, expected.X, got.X, 0.001)
+		assert.InDelta(t, expected.Y, got.Y, 0.001)
+		assert.InDelta(t, expected.Z, got.Z, 0.001)
+		assert.Equal(t, 1.0, got.W)
+	}
+}
+
+// Files are loaded as PPM or PNG depending on what they contain
+func TestCanvasLoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	ppmFile := filepath.Join(dir, "texture.ppm")
+	assert.NoError(t, os.WriteFile(ppmFile, []byte("P3\n1 1\n255\n255 0 0\n"), 0644))
+
+	c, err := LoadCanvasFile(ppmFile)
+	assert.NoError(t, err)
+	assert.True(t, NewColor(1, 0, 0, 1).Equals(c.Get(0, 0)))
+
+	img := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, color.RGBA{0, 0, 255, 255})
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+
+	// The extension doesn't matter, only the contents
+	pngFile := filepath.Join(dir, "texture.img")
+	assert.NoError(t, os.WriteFile(pngFile, buf.Bytes(), 0644))
+
+	c, err = LoadCanvasFile(pngFile)
+	assert.NoError(t, err)
+	assert.True(t, NewColor(0, 0, 1, 1).Equals(c.Get(0, 0)))
+
+	_, err = LoadCanvasFile(filepath.Join(dir, "missing.png"))
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
@@ -0,0 +1,33 @@
+package rt
+
+import "math"
+
+// CheckerPattern alternates between A and B in unit cubes, like a 3D
+// chess board
+type CheckerPattern struct {
+	BasePattern
+	A Pattern
+	B Pattern
+}
+
+func NewCheckerPattern(a, b *Color) *CheckerPattern {
+	return NewNestedCheckerPattern(NewSolidPattern(a), NewSolidPattern(b))
+}
+
+// NewNestedCheckerPattern creates checkers whose squares are filled with
+// other patterns
+func NewNestedCheckerPattern(a, b Pattern) *CheckerPattern {
+	return &CheckerPattern{
+		BasePattern: NewBasePattern(),
+		A:           a,
+		B:           b,
+	}
+}
+
+func (c *CheckerPattern) LocalPatternAt(p *Point) *Color {
+	s := math.Floor(p.X) + math.Floor(p.Y) + math.Floor(p.Z)
+	if int(s)%2 == 0 {
+		return PatternAt(c.A, p)
+	}
+	return PatternAt(c.B, p)
+}
@@ -0,0 +1,73 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: Checkers should repeat in x
+// Given pattern ← checkers_pattern(white, black)
+// Then pattern_at(pattern, point(0, 0, 0)) = white
+// And pattern_at(pattern, point(0.99, 0, 0)) = white
+// And pattern_at(pattern, point(1.01, 0, 0)) = black
+func TestCheckerPatternRepeatsX(t *testing.T) {
+	p := NewCheckerPattern(patternWhite, patternBlack)
+
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0, 0))))
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0.99, 0, 0))))
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(1.01, 0, 0))))
+}
+
+// Scenario: Checkers should repeat in y
+// Given pattern ← checkers_pattern(white, black)
+// Then pattern_at(pattern, point(0, 0, 0)) = white
+// And pattern_at(pattern, point(0, 0.99, 0)) = white
+// And pattern_at(pattern, point(0, 1.01, 0)) = black
+func TestCheckerPatternRepeatsY(t *testing.T) {
+	p := NewCheckerPattern(patternWhite, patternBlack)
+
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0, 0))))
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0.99, 0))))
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(0, 1.01, 0))))
+}
+
+// Scenario: Checkers should repeat in z
+// Given pattern ← checkers_pattern(white, black)
+// Then pattern_at(pattern, point(0, 0, 0)) = white
+// And pattern_at(pattern, point(0, 0, 0.99)) = white
+// And pattern_at(pattern, point(0, 0, 1.01)) = black
+func TestCheckerPatternRepeatsZ(t *testing.T) {
+	p := NewCheckerPattern(patternWhite, patternBlack)
+
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0, 0))))
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0, 0.99))))
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(0, 0, 1.01))))
+}
+
+// Negative coordinates keep alternating rather than mirroring about 0
+func TestCheckerPatternNegative(t *testing.T) {
+	p := NewCheckerPattern(patternWhite, patternBlack)
+
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(-0.5, 0, 0))))
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(-0.5, -0.5, 0))))
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(-0.5, -0.5, -0.5))))
+}
+
+// Checkers can be filled with other patterns, which are evaluated in the
+// checker's space and then their own
+func TestCheckerPatternNested(t *testing.T) {
+	red := NewColor(1, 0, 0, 1)
+	blue := NewColor(0, 0, 1, 1)
+
+	a := NewStripePattern(patternWhite, patternBlack)
+	a.SetTransform(NewTransform().Scale(0.25, 1, 1))
+	b := NewStripePattern(red, blue)
+	p := NewNestedCheckerPattern(a, b)
+
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0.1, 0, 0))))
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(0.3, 0, 0))))
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0.6, 0, 0))))
+	assert.True(t, blue.Equals(p.LocalPatternAt(NewPoint(1.5, 0, 0))))
+	assert.True(t, red.Equals(p.LocalPatternAt(NewPoint(0.5, 1.5, 0))))
+}
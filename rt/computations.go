@@ -0,0 +1,108 @@
+package rt
+
+import "math"
+
+// Computations holds the precomputed state of an intersection needed to shade it
+type Computations struct {
+	T         float64
+	Object    Shape
+	Point     *Point
+	OverPoint *Point
+	EyeV      *Vector
+	NormalV   *Vector
+	ReflectV  *Vector
+	Inside    bool
+	// UnderPoint sits just below the surface, where refracted rays start
+	UnderPoint *Point
+	// N1 and N2 are the refractive indices either side of the hit
+	N1 float64
+	N2 float64
+}
+
+// PrepareComputations precomputes the state of the hit i. xs is every
+// intersection along the ray, which is needed to know what the hit is
+// inside of when working out the refractive indices.
+func PrepareComputations(i *Intersection, r *Ray, xs Intersections) *Computations {
+
+	c := Computations{
+		T:      i.T,
+		Object: i.Object,
+	}
+
+	c.Point = r.Position(c.T)
+	eyev := r.Direction.Neg()
+	c.EyeV = &eyev
+	c.NormalV = NormalAt(c.Object, c.Point, i)
+
+	// If the normal points away from the eye we are inside the shape
+	if c.NormalV.Dot(c.EyeV) < 0 {
+		c.Inside = true
+		n := c.NormalV.Neg()
+		c.NormalV = &n
+	}
+
+	c.ReflectV = r.Direction.Reflect(c.NormalV)
+
+	// Nudge the point just above the surface so floating point error
+	// doesn't leave it below the surface it was found on
+	c.OverPoint = c.Point.Add(c.NormalV.Multi(SMALL_NUMBER_F64))
+	c.UnderPoint = c.Point.Sub(c.NormalV.Multi(SMALL_NUMBER_F64))
+	c.UnderPoint.W = 1
+
+	c.N1, c.N2 = refractiveIndices(i, xs)
+
+	return &c
+}
+
+// refractiveIndices walks the intersections keeping track of which objects
+// the ray is inside, to find the indices of the material being left and
+// the material being entered at the hit
+func refractiveIndices(hit *Intersection, xs Intersections) (float64, float64) {
+	n1, n2 := 1.0, 1.0
+	containers := []Shape{}
+
+	for _, i := range xs {
+		if i == hit && len(containers) > 0 {
+			n1 = containers[len(containers)-1].Material().RefractiveIndex
+		}
+
+		found := false
+		for j, s := range containers {
+			if s == i.Object {
+				containers = append(containers[:j], containers[j+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			containers = append(containers, i.Object)
+		}
+
+		if i == hit {
+			if len(containers) > 0 {
+				n2 = containers[len(containers)-1].Material().RefractiveIndex
+			}
+			break
+		}
+	}
+
+	return n1, n2
+}
+
+// Schlick approximates the Fresnel effect, returning how much of the
+// light is reflected rather than refracted at the hit
+func (c *Computations) Schlick() float64 {
+	cos := c.EyeV.Dot(c.NormalV)
+
+	if c.N1 > c.N2 {
+		n := c.N1 / c.N2
+		sin2t := n * n * (1 - cos*cos)
+		if sin2t > 1 {
+			return 1
+		}
+		cos = math.Sqrt(1 - sin2t)
+	}
+
+	r0 := math.Pow((c.N1-c.N2)/(c.N1+c.N2), 2)
+	return r0 + (1-r0)*math.Pow(1-cos, 5)
+}
@@ -0,0 +1,236 @@
+package rt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: Precomputing the state of an intersection
+// Given r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And shape ← sphere()
+// And i ← intersection(4, shape)
+// When comps ← prepare_computations(i, r)
+// Then comps.t = i.t
+// And comps.object = i.object
+// And comps.point = point(0, 0, -1)
+// And comps.eyev = vector(0, 0, -1)
+// And comps.normalv = vector(0, 0, -1)
+func TestComputationsPrepare(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	s := NewSphere()
+	i := NewIntersection(4, s)
+
+	c := PrepareComputations(i, r, NewIntersections(i))
+
+	assert.Equal(t, i.T, c.T)
+	assert.Same(t, s, c.Object)
+	assert.True(t, NewPoint(0, 0, -1).Equals(c.Point))
+	assert.True(t, NewVector(0, 0, -1).Equals(c.EyeV))
+	assert.True(t, NewVector(0, 0, -1).Equals(c.NormalV))
+}
+
+// Scenario: The hit, when an intersection occurs on the outside
+// Given r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And shape ← sphere()
+// And i ← intersection(4, shape)
+// When comps ← prepare_computations(i, r)
+// Then comps.inside = false
+func TestComputationsOutside(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	s := NewSphere()
+	i := NewIntersection(4, s)
+
+	c := PrepareComputations(i, r, NewIntersections(i))
+
+	assert.False(t, c.Inside)
+}
+
+// Scenario: The hit, when an intersection occurs on the inside
+// Given r ← ray(point(0, 0, 0), vector(0, 0, 1))
+// And shape ← sphere()
+// And i ← intersection(1, shape)
+// When comps ← prepare_computations(i, r)
+// Then comps.point = point(0, 0, 1)
+// And comps.eyev = vector(0, 0, -1)
+// And comps.inside = true
+// # normal would have been (0, 0, 1), but is inverted!
+// And comps.normalv = vector(0, 0, -1)
+func TestComputationsInside(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, 0), NewVector(0, 0, 1))
+	s := NewSphere()
+	i := NewIntersection(1, s)
+
+	c := PrepareComputations(i, r, NewIntersections(i))
+
+	assert.True(t, NewPoint(0, 0, 1).Equals(c.Point))
+	assert.True(t, NewVector(0, 0, -1).Equals(c.EyeV))
+	assert.True(t, c.Inside)
+	assert.True(t, NewVector(0, 0, -1).Equals(c.NormalV))
+}
+
+// Scenario: The hit should offset the point
+// Given r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And shape ← sphere() with:
+// | transform | translation(0, 0, 1) |
+// And i ← intersection(5, shape)
+// When comps ← prepare_computations(i, r)
+// Then comps.over_point.z < -EPSILON/2
+// And comps.point.z > comps.over_point.z
+func TestComputationsOverPoint(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	s := NewSphere()
+	s.SetTransform(NewTransform().Translate(0, 0, 1))
+	i := NewIntersection(5, s)
+
+	c := PrepareComputations(i, r, NewIntersections(i))
+
+	assert.Less(t, c.OverPoint.Z, -SMALL_NUMBER_F64/2)
+	assert.Greater(t, c.Point.Z, c.OverPoint.Z)
+}
+
+// Scenario: Precomputing the reflection vector
+// Given shape ← plane()
+// And r ← ray(point(0, 1, -1), vector(0, -√2/2, √2/2))
+// And i ← intersection(√2, shape)
+// When comps ← prepare_computations(i, r)
+// Then comps.reflectv = vector(0, √2/2, √2/2)
+func TestComputationsReflectV(t *testing.T) {
+	s := NewPlane()
+	r := NewRay(NewPoint(0, 1, -1), NewVector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	i := NewIntersection(math.Sqrt2, s)
+
+	c := PrepareComputations(i, r, NewIntersections(i))
+
+	assert.True(t, NewVector(0, math.Sqrt2/2, math.Sqrt2/2).Equals(c.ReflectV))
+}
+
+// Scenario Outline: Finding n1 and n2 at various intersections
+// Given A ← glass_sphere() with:
+// | transform | scaling(2, 2, 2) |
+// | material.refractive_index | 1.5 |
+// And B ← glass_sphere() with:
+// | transform | translation(0, 0, -0.25) |
+// | material.refractive_index | 2.0 |
+// And C ← glass_sphere() with:
+// | transform | translation(0, 0, 0.25) |
+// | material.refractive_index | 2.5 |
+// And r ← ray(point(0, 0, -4), vector(0, 0, 1))
+// And xs ← intersections(2:A, 2.75:B, 3.25:C, 4.75:B, 5.25:C, 6:A)
+// When comps ← prepare_computations(xs[<index>], r, xs)
+// Then comps.n1 = <n1>
+// And comps.n2 = <n2>
+func TestComputationsRefractiveIndices(t *testing.T) {
+	a := newGlassSphere()
+	a.SetTransform(NewTransform().Scale(2, 2, 2))
+	a.Material().RefractiveIndex = 1.5
+
+	b := newGlassSphere()
+	b.SetTransform(NewTransform().Translate(0, 0, -0.25))
+	b.Material().RefractiveIndex = 2.0
+
+	c := newGlassSphere()
+	c.SetTransform(NewTransform().Translate(0, 0, 0.25))
+	c.Material().RefractiveIndex = 2.5
+
+	r := NewRay(NewPoint(0, 0, -4), NewVector(0, 0, 1))
+	xs := NewIntersections(
+		NewIntersection(2, a),
+		NewIntersection(2.75, b),
+		NewIntersection(3.25, c),
+		NewIntersection(4.75, b),
+		NewIntersection(5.25, c),
+		NewIntersection(6, a),
+	)
+
+	tests := []struct {
+		n1, n2 float64
+	}{
+		{1.0, 1.5},
+		{1.5, 2.0},
+		{2.0, 2.5},
+		{2.5, 2.5},
+		{2.5, 1.5},
+		{1.5, 1.0},
+	}
+
+	for i, tt := range tests {
+		comps := PrepareComputations(xs[i], r, xs)
+		assert.Equal(t, tt.n1, comps.N1, "n1 at index %d", i)
+		assert.Equal(t, tt.n2, comps.N2, "n2 at index %d", i)
+	}
+}
+
+// Scenario: The under point is offset below the surface
+// Given r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And shape ← glass_sphere() with:
+// | transform | translation(0, 0, 1) |
+// And i ← intersection(5, shape)
+// And xs ← intersections(i)
+// When comps ← prepare_computations(i, r, xs)
+// Then comps.under_point.z > EPSILON/2
+// And comps.point.z < comps.under_point.z
+func TestComputationsUnderPoint(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	s := newGlassSphere()
+	s.SetTransform(NewTransform().Translate(0, 0, 1))
+	i := NewIntersection(5, s)
+	xs := NewIntersections(i)
+
+	c := PrepareComputations(i, r, xs)
+
+	assert.Greater(t, c.UnderPoint.Z, SMALL_NUMBER_F64/2)
+	assert.Less(t, c.Point.Z, c.UnderPoint.Z)
+}
+
+// Scenario: The Schlick approximation under total internal reflection
+// Given shape ← glass_sphere()
+// And r ← ray(point(0, 0, √2/2), vector(0, 1, 0))
+// And xs ← intersections(-√2/2:shape, √2/2:shape)
+// When comps ← prepare_computations(xs[1], r, xs)
+// And reflectance ← schlick(comps)
+// Then reflectance = 1.0
+func TestComputationsSchlickTotalInternalReflection(t *testing.T) {
+	s := newGlassSphere()
+	r := NewRay(NewPoint(0, 0, math.Sqrt2/2), NewVector(0, 1, 0))
+	xs := NewIntersections(NewIntersection(-math.Sqrt2/2, s), NewIntersection(math.Sqrt2/2, s))
+
+	c := PrepareComputations(xs[1], r, xs)
+
+	assert.Equal(t, 1.0, c.Schlick())
+}
+
+// Scenario: The Schlick approximation with a perpendicular viewing angle
+// Given shape ← glass_sphere()
+// And r ← ray(point(0, 0, 0), vector(0, 1, 0))
+// And xs ← intersections(-1:shape, 1:shape)
+// When comps ← prepare_computations(xs[1], r, xs)
+// And reflectance ← schlick(comps)
+// Then reflectance = 0.04
+func TestComputationsSchlickPerpendicular(t *testing.T) {
+	s := newGlassSphere()
+	r := NewRay(NewPoint(0, 0, 0), NewVector(0, 1, 0))
+	xs := NewIntersections(NewIntersection(-1, s), NewIntersection(1, s))
+
+	c := PrepareComputations(xs[1], r, xs)
+
+	assert.InDelta(t, 0.04, c.Schlick(), 0.0001)
+}
+
+// Scenario: The Schlick approximation with small angle and n2 > n1
+// Given shape ← glass_sphere()
+// And r ← ray(point(0, 0.99, -2), vector(0, 0, 1))
+// And xs ← intersections(1.8589:shape)
+// When comps ← prepare_computations(xs[0], r, xs)
+// And reflectance ← schlick(comps)
+// Then reflectance = 0.48873
+func TestComputationsSchlickSmallAngle(t *testing.T) {
+	s := newGlassSphere()
+	r := NewRay(NewPoint(0, 0.99, -2), NewVector(0, 0, 1))
+	xs := NewIntersections(NewIntersection(1.8589, s))
+
+	c := PrepareComputations(xs[0], r, xs)
+
+	assert.InDelta(t, 0.48873, c.Schlick(), 0.0001)
+}
@@ -0,0 +1,118 @@
+package rt
+
+import "math"
+
+// Cone is a double napped cone around the y axis in object space, with its
+// tips meeting at the origin. Like Cylinder it is infinite unless Minimum
+// and Maximum are set, and Closed caps both ends.
+type Cone struct {
+	BaseShape
+	Minimum float64
+	Maximum float64
+	Closed  bool
+}
+
+func NewCone() *Cone {
+	return &Cone{
+		BaseShape: NewBaseShape(),
+		Minimum:   math.Inf(-1),
+		Maximum:   math.Inf(1),
+		Closed:    false,
+	}
+}
+
+func (c *Cone) intersectCaps(r *Ray, xs Intersections) Intersections {
+
+	if !c.Closed || math.Abs(r.Direction.Y) < SMALL_NUMBER_F64 {
+		return xs
+	}
+
+	// The radius of a cone's cap is the absolute y value it sits at, so an
+	// unbounded end has no cap, it would be infinitely wide and far away
+	if !math.IsInf(c.Minimum, 0) {
+		t := (c.Minimum - r.Origin.Y) / r.Direction.Y
+		if checkCap(r, t, math.Abs(c.Minimum)) {
+			xs = append(xs, NewIntersection(t, c))
+		}
+	}
+
+	if !math.IsInf(c.Maximum, 0) {
+		t := (c.Maximum - r.Origin.Y) / r.Direction.Y
+		if checkCap(r, t, math.Abs(c.Maximum)) {
+			xs = append(xs, NewIntersection(t, c))
+		}
+	}
+
+	return xs
+}
+
+func (c *Cone) LocalIntersect(r *Ray) Intersections {
+	xs := Intersections{}
+
+	o, d := r.Origin, r.Direction
+
+	a := d.X*d.X - d.Y*d.Y + d.Z*d.Z
+	b := 2*o.X*d.X - 2*o.Y*d.Y + 2*o.Z*d.Z
+	cc := o.X*o.X - o.Y*o.Y + o.Z*o.Z
+
+	aZero := math.Abs(a) < SMALL_NUMBER_F64
+
+	switch {
+	case aZero && math.Abs(b) < SMALL_NUMBER_F64:
+		// The ray misses both halves entirely
+
+	case aZero:
+		// The ray is parallel to one half of the cone, so it can only
+		// hit the other half once
+		t := -cc / (2 * b)
+		y := o.Y + t*d.Y
+		if c.Minimum < y && y < c.Maximum {
+			xs = append(xs, NewIntersection(t, c))
+		}
+
+	default:
+		disc := b*b - 4*a*cc
+
+		if disc < 0 {
+			return xs
+		}
+
+		t0 := (-b - math.Sqrt(disc)) / (2 * a)
+		t1 := (-b + math.Sqrt(disc)) / (2 * a)
+
+		y0 := o.Y + t0*d.Y
+		if c.Minimum < y0 && y0 < c.Maximum {
+			xs = append(xs, NewIntersection(t0, c))
+		}
+
+		y1 := o.Y + t1*d.Y
+		if c.Minimum < y1 && y1 < c.Maximum {
+			xs = append(xs, NewIntersection(t1, c))
+		}
+	}
+
+	xs = c.intersectCaps(r, xs)
+	xs.Sort()
+
+	return xs
+}
+
+func (c *Cone) LocalNormalAt(p *Point, hit *Intersection) *Vector {
+	dist := p.X*p.X + p.Z*p.Z
+
+	// Like the caps themselves, the radius is the y value they sit at
+	if dist < c.Maximum*c.Maximum && p.Y >= c.Maximum-SMALL_NUMBER_F64 {
+		return NewVector(0, 1, 0)
+	}
+
+	if dist < c.Minimum*c.Minimum && p.Y <= c.Minimum+SMALL_NUMBER_F64 {
+		return NewVector(0, -1, 0)
+	}
+
+	y := math.Sqrt(dist)
+	if p.Y > 0 {
+		y = -y
+	}
+
+	return NewVector(p.X, y, p.Z)
+}
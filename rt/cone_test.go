@@ -0,0 +1,159 @@
+package rt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario Outline: Intersecting a cone with a ray
+// Given shape ← cone()
+// And direction ← normalize(<direction>)
+// And r ← ray(<origin>, direction)
+// When xs ← local_intersect(shape, r)
+// Then xs.count = 2
+// And xs[0].t = <t0>
+// And xs[1].t = <t1>
+//
+// Examples:
+// | origin | direction | t0 | t1 |
+// | point(0, 0, -5) | vector(0, 0, 1) | 5 | 5 |
+// | point(0, 0, -5) | vector(1, 1, 1) | 8.66025 | 8.66025 |
+// | point(1, 1, -5) | vector(-0.5, -1, 1) | 4.55006 | 49.44994 |
+func TestConeIntersect(t *testing.T) {
+	c := NewCone()
+
+	examples := []struct {
+		origin    *Point
+		direction *Vector
+		t0, t1    float64
+	}{
+		{NewPoint(0, 0, -5), NewVector(0, 0, 1), 5, 5},
+		{NewPoint(0, 0, -5), NewVector(1, 1, 1), 8.66025, 8.66025},
+		{NewPoint(1, 1, -5), NewVector(-0.5, -1, 1), 4.55006, 49.44994},
+	}
+
+	for _, e := range examples {
+		xs := c.LocalIntersect(NewRay(e.origin, e.direction.Norm()))
+
+		assert.Len(t, xs, 2)
+		assert.InDelta(t, e.t0, xs[0].T, 0.0001)
+		assert.InDelta(t, e.t1, xs[1].T, 0.0001)
+	}
+}
+
+// Scenario: Intersecting a cone with a ray parallel to one of its halves
+// Given shape ← cone()
+// And direction ← normalize(vector(0, 1, 1))
+// And r ← ray(point(0, 0, -1), direction)
+// When xs ← local_intersect(shape, r)
+// Then xs.count = 1
+// And xs[0].t = 0.35355
+func TestConeIntersectParallel(t *testing.T) {
+	c := NewCone()
+
+	xs := c.LocalIntersect(NewRay(NewPoint(0, 0, -1), NewVector(0, 1, 1).Norm()))
+
+	assert.Len(t, xs, 1)
+	assert.InDelta(t, 0.35355, xs[0].T, 0.00001)
+}
+
+// Scenario Outline: Intersecting a cone's end caps
+// Given shape ← cone()
+// And shape.minimum ← -0.5
+// And shape.maximum ← 0.5
+// And shape.closed ← true
+// And direction ← normalize(<direction>)
+// And r ← ray(<origin>, direction)
+// When xs ← local_intersect(shape, r)
+// Then xs.count = <count>
+//
+// Examples:
+// | origin | direction | count |
+// | point(0, 0, -5) | vector(0, 1, 0) | 0 |
+// | point(0, 0, -0.25) | vector(0, 1, 1) | 2 |
+// | point(0, 0, -0.25) | vector(0, 1, 0) | 4 |
+func TestConeIntersectCaps(t *testing.T) {
+	c := NewCone()
+	c.Minimum = -0.5
+	c.Maximum = 0.5
+	c.Closed = true
+
+	examples := []struct {
+		origin    *Point
+		direction *Vector
+		count     int
+	}{
+		{NewPoint(0, 0, -5), NewVector(0, 1, 0), 0},
+		{NewPoint(0, 0, -0.25), NewVector(0, 1, 1), 2},
+		{NewPoint(0, 0, -0.25), NewVector(0, 1, 0), 4},
+	}
+
+	for _, e := range examples {
+		xs := c.LocalIntersect(NewRay(e.origin, e.direction.Norm()))
+
+		assert.Len(t, xs, e.count)
+	}
+}
+
+// Scenario Outline: Computing the normal vector on a cone
+// Given shape ← cone()
+// When n ← local_normal_at(shape, <point>)
+// Then n = <normal>
+//
+// Examples:
+// | point | normal |
+// | point(0, 0, 0) | vector(0, 0, 0) |
+// | point(1, 1, 1) | vector(1, -√2, 1) |
+// | point(-1, -1, 0) | vector(-1, 1, 0) |
+func TestConeNormal(t *testing.T) {
+	c := NewCone()
+
+	examples := []struct {
+		point  *Point
+		normal *Vector
+	}{
+		{NewPoint(0, 0, 0), NewVector(0, 0, 0)},
+		{NewPoint(1, 1, 1), NewVector(1, -math.Sqrt2, 1)},
+		{NewPoint(-1, -1, 0), NewVector(-1, 1, 0)},
+	}
+
+	for _, e := range examples {
+		assert.True(t, e.normal.Equals(c.LocalNormalAt(e.point, nil)))
+	}
+}
+
+// The caps of a closed cone face straight up and down
+func TestConeNormalCaps(t *testing.T) {
+	c := NewCone()
+	c.Minimum = -1
+	c.Maximum = 2
+	c.Closed = true
+
+	assert.True(t, NewVector(0, 1, 0).Equals(c.LocalNormalAt(NewPoint(1.5, 2, 0), nil)))
+	assert.True(t, NewVector(0, -1, 0).Equals(c.LocalNormalAt(NewPoint(0, -1, 0.5), nil)))
+}
+
+// An unbounded end of a closed cone has no cap, rather than one at infinity
+func TestConeIntersectUnboundedCaps(t *testing.T) {
+	c := NewCone()
+	c.Closed = true
+
+	r := NewRay(NewPoint(0.5, 0, -5), NewVector(1, 2, 1).Norm())
+	xs := c.LocalIntersect(r)
+
+	for _, i := range xs {
+		assert.False(t, math.IsInf(i.T, 0))
+	}
+	assert.Len(t, xs, 2)
+
+	c.Minimum = -1
+	r = NewRay(NewPoint(0.5, -2, 0), NewVector(0, 1, 0))
+	xs = c.LocalIntersect(r)
+
+	assert.Len(t, xs, 3)
+	assert.True(t, Equal(1, xs[0].T))
+	assert.True(t, Equal(1.5, xs[1].T))
+	assert.True(t, Equal(2.5, xs[2].T))
+}
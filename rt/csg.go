@@ -0,0 +1,102 @@
+package rt
+
+// CSGOperation is how a CSG combines its left and right shapes
+type CSGOperation int
+
+const (
+	// CSG_UNION keeps everything in either shape
+	CSG_UNION CSGOperation = iota
+	// CSG_INTERSECTION keeps only where the shapes overlap
+	CSG_INTERSECTION
+	// CSG_DIFFERENCE keeps the left shape with the right cut out of it
+	CSG_DIFFERENCE
+)
+
+// CSG is a shape built by combining two other shapes, either of which may
+// be a group or another CSG
+type CSG struct {
+	BaseShape
+	Operation CSGOperation
+	Left      Shape
+	Right     Shape
+}
+
+func NewCSG(op CSGOperation, left, right Shape) *CSG {
+	c := &CSG{
+		BaseShape: NewBaseShape(),
+		Operation: op,
+		Left:      left,
+		Right:     right,
+	}
+	left.SetParent(c)
+	right.SetParent(c)
+	return c
+}
+
+func (c *CSG) LocalIntersect(r *Ray) Intersections {
+	xs := append(Intersect(c.Left, r), Intersect(c.Right, r)...)
+	xs.Sort()
+	return c.FilterIntersections(xs)
+}
+
+// LocalNormalAt should never be called, normals are always found on the
+// children a ray actually hits
+func (c *CSG) LocalNormalAt(p *Point, hit *Intersection) *Vector {
+	panic("csgs do not have normals, normal_at should be called on a child")
+}
+
+// FilterIntersections keeps only the sorted intersections that lie on the
+// surface of the combined shape, tracking whether the ray is inside each
+// side as it goes
+func (c *CSG) FilterIntersections(xs Intersections) Intersections {
+	inl, inr := false, false
+	res := Intersections{}
+
+	for _, i := range xs {
+		lhit := Includes(c.Left, i.Object)
+
+		if IntersectionAllowed(c.Operation, lhit, inl, inr) {
+			res = append(res, i)
+		}
+
+		if lhit {
+			inl = !inl
+		} else {
+			inr = !inr
+		}
+	}
+
+	return res
+}
+
+// IntersectionAllowed decides if a hit is on the surface of a CSG. lhit is
+// whether the left shape was hit, inl and inr are whether the hit is
+// inside the left and right shapes.
+func IntersectionAllowed(op CSGOperation, lhit, inl, inr bool) bool {
+	switch op {
+	case CSG_UNION:
+		return (lhit && !inr) || (!lhit && !inl)
+	case CSG_INTERSECTION:
+		return (lhit && inr) || (!lhit && inl)
+	case CSG_DIFFERENCE:
+		return (lhit && !inr) || (!lhit && inl)
+	}
+	return false
+}
+
+// Includes checks if s is the shape, or contains it somewhere within its
+// groups and CSGs
+func Includes(s, shape Shape) bool {
+	switch c := s.(type) {
+	case *Group:
+		for _, child := range c.Children {
+			if Includes(child, shape) {
+				return true
+			}
+		}
+		return false
+	case *CSG:
+		return Includes(c.Left, shape) || Includes(c.Right, shape)
+	}
+	return s == shape
+}
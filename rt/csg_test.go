@@ -0,0 +1,190 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: CSG is created with an operation and two shapes
+// Given s1 ← sphere()
+// And s2 ← cube()
+// When c ← csg("union", s1, s2)
+// Then c.operation = "union"
+// And c.left = s1
+// And c.right = s2
+// And s1.parent = c
+// And s2.parent = c
+func TestCSGNew(t *testing.T) {
+	s1 := NewSphere()
+	s2 := NewCube()
+
+	c := NewCSG(CSG_UNION, s1, s2)
+
+	assert.Equal(t, CSG_UNION, c.Operation)
+	assert.Same(t, s1, c.Left)
+	assert.Same(t, s2, c.Right)
+	assert.Same(t, c, s1.Parent())
+	assert.Same(t, c, s2.Parent())
+}
+
+// Scenario Outline: Evaluating the rule for a CSG operation
+// When result ← intersection_allowed("<op>", <lhit>, <inl>, <inr>)
+// Then result = <result>
+func TestCSGIntersectionAllowed(t *testing.T) {
+	tests := []struct {
+		op             CSGOperation
+		lhit, inl, inr bool
+		result         bool
+	}{
+		{CSG_UNION, true, true, true, false},
+		{CSG_UNION, true, true, false, true},
+		{CSG_UNION, true, false, true, false},
+		{CSG_UNION, true, false, false, true},
+		{CSG_UNION, false, true, true, false},
+		{CSG_UNION, false, true, false, false},
+		{CSG_UNION, false, false, true, true},
+		{CSG_UNION, false, false, false, true},
+		{CSG_INTERSECTION, true, true, true, true},
+		{CSG_INTERSECTION, true, true, false, false},
+		{CSG_INTERSECTION, true, false, true, true},
+		{CSG_INTERSECTION, true, false, false, false},
+		{CSG_INTERSECTION, false, true, true, true},
+		{CSG_INTERSECTION, false, true, false, true},
+		{CSG_INTERSECTION, false, false, true, false},
+		{CSG_INTERSECTION, false, false, false, false},
+		{CSG_DIFFERENCE, true, true, true, false},
+		{CSG_DIFFERENCE, true, true, false, true},
+		{CSG_DIFFERENCE, true, false, true, false},
+		{CSG_DIFFERENCE, true, false, false, true},
+		{CSG_DIFFERENCE, false, true, true, true},
+		{CSG_DIFFERENCE, false, true, false, true},
+		{CSG_DIFFERENCE, false, false, true, false},
+		{CSG_DIFFERENCE, false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.result, IntersectionAllowed(tt.op, tt.lhit, tt.inl, tt.inr), "%+v", tt)
+	}
+}
+
+// Scenario Outline: Filtering a list of intersections
+// Given s1 ← sphere()
+// And s2 ← cube()
+// And c ← csg("<operation>", s1, s2)
+// And xs ← intersections(1:s1, 2:s2, 3:s1, 4:s2)
+// When result ← filter_intersections(c, xs)
+// Then result.count = 2
+// And result[0] = xs[<x0>]
+// And result[1] = xs[<x1>]
+func TestCSGFilterIntersections(t *testing.T) {
+	tests := []struct {
+		op     CSGOperation
+		x0, x1 int
+	}{
+		{CSG_UNION, 0, 3},
+		{CSG_INTERSECTION, 1, 2},
+		{CSG_DIFFERENCE, 0, 1},
+	}
+
+	for _, tt := range tests {
+		s1 := NewSphere()
+		s2 := NewCube()
+		c := NewCSG(tt.op, s1, s2)
+		xs := NewIntersections(
+			NewIntersection(1, s1),
+			NewIntersection(2, s2),
+			NewIntersection(3, s1),
+			NewIntersection(4, s2),
+		)
+
+		res := c.FilterIntersections(xs)
+
+		assert.Len(t, res, 2)
+		assert.Same(t, xs[tt.x0], res[0])
+		assert.Same(t, xs[tt.x1], res[1])
+	}
+}
+
+// Scenario: A ray misses a CSG object
+// Given c ← csg("union", sphere(), cube())
+// And r ← ray(point(0, 2, -5), vector(0, 0, 1))
+// When xs ← local_intersect(c, r)
+// Then xs is empty
+func TestCSGIntersectMiss(t *testing.T) {
+	c := NewCSG(CSG_UNION, NewSphere(), NewCube())
+	r := NewRay(NewPoint(0, 2, -5), NewVector(0, 0, 1))
+
+	xs := c.LocalIntersect(r)
+
+	assert.Len(t, xs, 0)
+}
+
+// Scenario: A ray hits a CSG object
+// Given s1 ← sphere()
+// And s2 ← sphere()
+// And set_transform(s2, translation(0, 0, 0.5))
+// And c ← csg("union", s1, s2)
+// And r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// When xs ← local_intersect(c, r)
+// Then xs.count = 2
+// And xs[0].t = 4
+// And xs[0].object = s1
+// And xs[1].t = 6.5
+// And xs[1].object = s2
+func TestCSGIntersectHit(t *testing.T) {
+	s1 := NewSphere()
+	s2 := NewSphere()
+	s2.SetTransform(NewTransform().Translate(0, 0, 0.5))
+	c := NewCSG(CSG_UNION, s1, s2)
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+
+	xs := c.LocalIntersect(r)
+
+	assert.Len(t, xs, 2)
+	assert.True(t, Equal(4, xs[0].T))
+	assert.Same(t, s1, xs[0].Object)
+	assert.True(t, Equal(6.5, xs[1].T))
+	assert.Same(t, s2, xs[1].Object)
+}
+
+// Groups and CSGs nested inside a CSG count as part of the side they are on
+func TestCSGIncludes(t *testing.T) {
+	s1 := NewSphere()
+	s2 := NewCube()
+	s3 := NewCylinder()
+	g := NewGroup()
+	g.AddChild(s1)
+	inner := NewCSG(CSG_DIFFERENCE, s2, s3)
+	c := NewCSG(CSG_UNION, g, inner)
+
+	assert.True(t, Includes(s1, s1))
+	assert.False(t, Includes(s1, s2))
+	assert.True(t, Includes(g, s1))
+	assert.False(t, Includes(g, s2))
+	assert.True(t, Includes(inner, s3))
+	assert.True(t, Includes(c, s1))
+	assert.True(t, Includes(c, s3))
+	assert.False(t, Includes(c, NewSphere()))
+}
+
+// A sphere with a smaller sphere cut out of its centre is hollow, so a ray
+// through it hits both the outer and inner surfaces
+func TestCSGNestedDifference(t *testing.T) {
+	outer := NewSphere()
+	inner := NewSphere()
+	inner.SetTransform(NewTransform().Scale(0.5, 0.5, 0.5))
+	g := NewGroup()
+	g.AddChild(outer)
+	c := NewCSG(CSG_DIFFERENCE, g, inner)
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+
+	xs := Intersect(c, r)
+
+	assert.Len(t, xs, 4)
+	assert.True(t, Equal(4, xs[0].T))
+	assert.True(t, Equal(4.5, xs[1].T))
+	assert.Same(t, inner, xs[1].Object)
+	assert.True(t, Equal(5.5, xs[2].T))
+	assert.True(t, Equal(6, xs[3].T))
+}
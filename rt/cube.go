@@ -0,0 +1,67 @@
+package rt
+
+import "math"
+
+// Cube is an axis aligned box from -1 to 1 on every axis in object space
+type Cube struct {
+	BaseShape
+}
+
+func NewCube() *Cube {
+	return &Cube{
+		BaseShape: NewBaseShape(),
+	}
+}
+
+// checkAxis finds where a ray crosses the pair of planes at -1 and 1 on a single axis
+func checkAxis(origin, direction float64) (tmin, tmax float64) {
+	tminNum := -1 - origin
+	tmaxNum := 1 - origin
+
+	if math.Abs(direction) >= SMALL_NUMBER_F64 {
+		tmin = tminNum / direction
+		tmax = tmaxNum / direction
+	} else {
+		tmin = tminNum * math.Inf(1)
+		tmax = tmaxNum * math.Inf(1)
+	}
+
+	if tmin > tmax {
+		return tmax, tmin
+	}
+	return tmin, tmax
+}
+
+func (c *Cube) LocalIntersect(r *Ray) Intersections {
+	xtmin, xtmax := checkAxis(r.Origin.X, r.Direction.X)
+	ytmin, ytmax := checkAxis(r.Origin.Y, r.Direction.Y)
+	ztmin, ztmax := checkAxis(r.Origin.Z, r.Direction.Z)
+
+	// The ray is in the cube between the last slab entered and the first exited
+	tmin := math.Max(xtmin, math.Max(ytmin, ztmin))
+	tmax := math.Min(xtmax, math.Min(ytmax, ztmax))
+
+	if tmin > tmax {
+		return Intersections{}
+	}
+
+	return NewIntersections(
+		NewIntersection(tmin, c),
+		NewIntersection(tmax, c),
+	)
+}
+
+// LocalNormalAt picks the face from the component with the largest magnitude
+func (c *Cube) LocalNormalAt(p *Point, hit *Intersection) *Vector {
+	ax, ay, az := math.Abs(p.X), math.Abs(p.Y), math.Abs(p.Z)
+	maxc := math.Max(ax, math.Max(ay, az))
+
+	switch maxc {
+	case ax:
+		return NewVector(p.X, 0, 0)
+	case ay:
+		return NewVector(0, p.Y, 0)
+	default:
+		return NewVector(0, 0, p.Z)
+	}
+}
@@ -0,0 +1,85 @@
+package rt
+
+import "math"
+
+// CubeFace is one of the six faces of a unit cube
+type CubeFace int
+
+const (
+	CUBE_FACE_LEFT CubeFace = iota
+	CUBE_FACE_RIGHT
+	CUBE_FACE_FRONT
+	CUBE_FACE_BACK
+	CUBE_FACE_UP
+	CUBE_FACE_DOWN
+)
+
+// FaceFromPoint finds which face of a unit cube the point is on, using the
+// coordinate with the largest magnitude
+func FaceFromPoint(p *Point) CubeFace {
+	coord := math.Max(math.Abs(p.X), math.Max(math.Abs(p.Y), math.Abs(p.Z)))
+
+	switch coord {
+	case p.X:
+		return CUBE_FACE_RIGHT
+	case -p.X:
+		return CUBE_FACE_LEFT
+	case p.Y:
+		return CUBE_FACE_UP
+	case -p.Y:
+		return CUBE_FACE_DOWN
+	case p.Z:
+		return CUBE_FACE_FRONT
+	}
+	return CUBE_FACE_BACK
+}
+
+// CubeUV maps a point on the given face of a unit cube to u and v, as if
+// looking at the face from outside the cube with up being towards +y, or
+// towards -z and +z for the top and bottom faces
+func CubeUV(face CubeFace, p *Point) (u, v float64) {
+	switch face {
+	case CUBE_FACE_LEFT:
+		return cubeUVCoord(p.Z + 1), cubeUVCoord(p.Y + 1)
+	case CUBE_FACE_RIGHT:
+		return cubeUVCoord(1 - p.Z), cubeUVCoord(p.Y + 1)
+	case CUBE_FACE_FRONT:
+		return cubeUVCoord(p.X + 1), cubeUVCoord(p.Y + 1)
+	case CUBE_FACE_BACK:
+		return cubeUVCoord(1 - p.X), cubeUVCoord(p.Y + 1)
+	case CUBE_FACE_UP:
+		return cubeUVCoord(p.X + 1), cubeUVCoord(1 - p.Z)
+	}
+	return cubeUVCoord(p.X + 1), cubeUVCoord(p.Z + 1)
+}
+
+// cubeUVCoord scales a coordinate from 0 to 2 across a face down to 0 to 1
+func cubeUVCoord(c float64) float64 {
+	return math.Mod(c, 2) / 2
+}
+
+// CubeMapPattern uses a different UVPattern for each face of a unit cube,
+// indexed by CubeFace. Useful for skyboxes and dice.
+type CubeMapPattern struct {
+	BasePattern
+	Faces [6]UVPattern
+}
+
+func NewCubeMapPattern(left, right, front, back, up, down UVPattern) *CubeMapPattern {
+	c := &CubeMapPattern{
+		BasePattern: NewBasePattern(),
+	}
+	c.Faces[CUBE_FACE_LEFT] = left
+	c.Faces[CUBE_FACE_RIGHT] = right
+	c.Faces[CUBE_FACE_FRONT] = front
+	c.Faces[CUBE_FACE_BACK] = back
+	c.Faces[CUBE_FACE_UP] = up
+	c.Faces[CUBE_FACE_DOWN] = down
+	return c
+}
+
+func (c *CubeMapPattern) LocalPatternAt(p *Point) *Color {
+	face := FaceFromPoint(p)
+	u, v := CubeUV(face, p)
+	return c.Faces[face].UVPatternAt(u, v)
+}
@@ -0,0 +1,169 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// uvAlignCheck colors the middle and each corner of the uv square
+// differently, to check a mapping is the right way around
+type uvAlignCheck struct {
+	Main, UL, UR, BL, BR *Color
+}
+
+func (a *uvAlignCheck) UVPatternAt(u, v float64) *Color {
+	switch {
+	case v > 0.8 && u < 0.2:
+		return a.UL
+	case v > 0.8 && u > 0.8:
+		return a.UR
+	case v < 0.2 && u < 0.2:
+		return a.BL
+	case v < 0.2 && u > 0.8:
+		return a.BR
+	}
+	return a.Main
+}
+
+var (
+	cubeRed    = NewColor(1, 0, 0, 1)
+	cubeYellow = NewColor(1, 1, 0, 1)
+	cubeBrown  = NewColor(1, 0.5, 0, 1)
+	cubeGreen  = NewColor(0, 1, 0, 1)
+	cubeCyan   = NewColor(0, 1, 1, 1)
+	cubeBlue   = NewColor(0, 0, 1, 1)
+	cubePurple = NewColor(1, 0, 1, 1)
+	cubeWhite  = NewColor(1, 1, 1, 1)
+)
+
+// Scenario Outline: Layout of the "align check" pattern
+// Given main ← color(1, 1, 1)
+// And ul ← color(1, 0, 0)
+// And ur ← color(1, 1, 0)
+// And bl ← color(0, 1, 0)
+// And br ← color(0, 1, 1)
+// And pattern ← uv_align_check(main, ul, ur, bl, br)
+// When c ← uv_pattern_at(pattern, <u>, <v>)
+// Then c = <expected>
+func TestCubeAlignCheck(t *testing.T) {
+	p := &uvAlignCheck{cubeWhite, cubeRed, cubeYellow, cubeGreen, cubeCyan}
+
+	assert.Same(t, cubeWhite, p.UVPatternAt(0.5, 0.5))
+	assert.Same(t, cubeRed, p.UVPatternAt(0.1, 0.9))
+	assert.Same(t, cubeYellow, p.UVPatternAt(0.9, 0.9))
+	assert.Same(t, cubeGreen, p.UVPatternAt(0.1, 0.1))
+	assert.Same(t, cubeCyan, p.UVPatternAt(0.9, 0.1))
+}
+
+// Scenario Outline: Identifying the face of a cube from a point
+// When face ← face_from_point(<point>)
+// Then face = <face>
+func TestCubeFaceFromPoint(t *testing.T) {
+	tests := []struct {
+		p    *Point
+		face CubeFace
+	}{
+		{NewPoint(-1, 0.5, -0.25), CUBE_FACE_LEFT},
+		{NewPoint(1.1, -0.75, 0.8), CUBE_FACE_RIGHT},
+		{NewPoint(0.1, 0.6, 0.9), CUBE_FACE_FRONT},
+		{NewPoint(-0.7, 0, -2), CUBE_FACE_BACK},
+		{NewPoint(0.5, 1, 0.9), CUBE_FACE_UP},
+		{NewPoint(-0.2, -1.3, 1.1), CUBE_FACE_DOWN},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.face, FaceFromPoint(tt.p), "%v", tt.p)
+	}
+}
+
+// Scenario Outline: UV mapping each face of a cube
+// When (u, v) ← cube_uv_<face>(<point>)
+// Then u = <u>
+// And v = <v>
+func TestCubeUV(t *testing.T) {
+	tests := []struct {
+		face CubeFace
+		p    *Point
+		u, v float64
+	}{
+		{CUBE_FACE_FRONT, NewPoint(-0.5, 0.5, 1), 0.25, 0.75},
+		{CUBE_FACE_FRONT, NewPoint(0.5, -0.5, 1), 0.75, 0.25},
+		{CUBE_FACE_BACK, NewPoint(0.5, 0.5, -1), 0.25, 0.75},
+		{CUBE_FACE_BACK, NewPoint(-0.5, -0.5, -1), 0.75, 0.25},
+		{CUBE_FACE_LEFT, NewPoint(-1, 0.5, -0.5), 0.25, 0.75},
+		{CUBE_FACE_LEFT, NewPoint(-1, -0.5, 0.5), 0.75, 0.25},
+		{CUBE_FACE_RIGHT, NewPoint(1, 0.5, 0.5), 0.25, 0.75},
+		{CUBE_FACE_RIGHT, NewPoint(1, -0.5, -0.5), 0.75, 0.25},
+		{CUBE_FACE_UP, NewPoint(-0.5, 1, -0.5), 0.25, 0.75},
+		{CUBE_FACE_UP, NewPoint(0.5, 1, 0.5), 0.75, 0.25},
+		{CUBE_FACE_DOWN, NewPoint(-0.5, -1, 0.5), 0.25, 0.75},
+		{CUBE_FACE_DOWN, NewPoint(0.5, -1, -0.5), 0.75, 0.25},
+	}
+
+	for _, tt := range tests {
+		u, v := CubeUV(tt.face, tt.p)
+		assert.InDelta(t, tt.u, u, SMALL_NUMBER_F64, "face %d %v", tt.face, tt.p)
+		assert.InDelta(t, tt.v, v, SMALL_NUMBER_F64, "face %d %v", tt.face, tt.p)
+	}
+}
+
+// Scenario Outline: Finding the colors on a mapped cube
+// When cube ← cube_map(left, front, right, back, up, down)
+// Then pattern_at(cube, <point>) = <color>
+func TestCubeMapPattern(t *testing.T) {
+	left := &uvAlignCheck{cubeYellow, cubeCyan, cubeRed, cubeBlue, cubeBrown}
+	front := &uvAlignCheck{cubeCyan, cubeRed, cubeYellow, cubeBrown, cubeGreen}
+	right := &uvAlignCheck{cubeRed, cubeYellow, cubePurple, cubeGreen, cubeWhite}
+	back := &uvAlignCheck{cubeGreen, cubePurple, cubeCyan, cubeWhite, cubeBlue}
+	up := &uvAlignCheck{cubeBrown, cubeCyan, cubePurple, cubeRed, cubeYellow}
+	down := &uvAlignCheck{cubePurple, cubeBrown, cubeGreen, cubeBlue, cubeWhite}
+
+	p := NewCubeMapPattern(left, right, front, back, up, down)
+
+	tests := []struct {
+		p        *Point
+		expected *Color
+	}{
+		// left
+		{NewPoint(-1, 0, 0), cubeYellow},
+		{NewPoint(-1, 0.9, -0.9), cubeCyan},
+		{NewPoint(-1, 0.9, 0.9), cubeRed},
+		{NewPoint(-1, -0.9, -0.9), cubeBlue},
+		{NewPoint(-1, -0.9, 0.9), cubeBrown},
+		// front
+		{NewPoint(0, 0, 1), cubeCyan},
+		{NewPoint(-0.9, 0.9, 1), cubeRed},
+		{NewPoint(0.9, 0.9, 1), cubeYellow},
+		{NewPoint(-0.9, -0.9, 1), cubeBrown},
+		{NewPoint(0.9, -0.9, 1), cubeGreen},
+		// right
+		{NewPoint(1, 0, 0), cubeRed},
+		{NewPoint(1, 0.9, 0.9), cubeYellow},
+		{NewPoint(1, 0.9, -0.9), cubePurple},
+		{NewPoint(1, -0.9, 0.9), cubeGreen},
+		{NewPoint(1, -0.9, -0.9), cubeWhite},
+		// back
+		{NewPoint(0, 0, -1), cubeGreen},
+		{NewPoint(0.9, 0.9, -1), cubePurple},
+		{NewPoint(-0.9, 0.9, -1), cubeCyan},
+		{NewPoint(0.9, -0.9, -1), cubeWhite},
+		{NewPoint(-0.9, -0.9, -1), cubeBlue},
+		// up
+		{NewPoint(0, 1, 0), cubeBrown},
+		{NewPoint(-0.9, 1, -0.9), cubeCyan},
+		{NewPoint(0.9, 1, -0.9), cubePurple},
+		{NewPoint(-0.9, 1, 0.9), cubeRed},
+		{NewPoint(0.9, 1, 0.9), cubeYellow},
+		// down
+		{NewPoint(0, -1, 0), cubePurple},
+		{NewPoint(-0.9, -1, 0.9), cubeBrown},
+		{NewPoint(0.9, -1, 0.9), cubeGreen},
+		{NewPoint(-0.9, -1, -0.9), cubeBlue},
+		{NewPoint(0.9, -1, -0.9), cubeWhite},
+	}
+
+	for _, tt := range tests {
+		assert.Same(t, tt.expected, PatternAt(p, tt.p), "%v", tt.p)
+	}
+}
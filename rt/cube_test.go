@@ -0,0 +1,124 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario Outline: A ray intersects a cube
+// Given c ← cube()
+// And r ← ray(<origin>, <direction>)
+// When xs ← local_intersect(c, r)
+// Then xs.count = 2
+// And xs[0].t = <t1>
+// And xs[1].t = <t2>
+//
+// Examples:
+// | | origin | direction | t1 | t2 |
+// | +x | point(5, 0.5, 0) | vector(-1, 0, 0) | 4 | 6 |
+// | -x | point(-5, 0.5, 0) | vector(1, 0, 0) | 4 | 6 |
+// | +y | point(0.5, 5, 0) | vector(0, -1, 0) | 4 | 6 |
+// | -y | point(0.5, -5, 0) | vector(0, 1, 0) | 4 | 6 |
+// | +z | point(0.5, 0, 5) | vector(0, 0, -1) | 4 | 6 |
+// | -z | point(0.5, 0, -5) | vector(0, 0, 1) | 4 | 6 |
+// | inside | point(0, 0.5, 0) | vector(0, 0, 1) | -1 | 1 |
+func TestCubeIntersect(t *testing.T) {
+	c := NewCube()
+
+	examples := []struct {
+		origin    *Point
+		direction *Vector
+		t1, t2    float64
+	}{
+		{NewPoint(5, 0.5, 0), NewVector(-1, 0, 0), 4, 6},
+		{NewPoint(-5, 0.5, 0), NewVector(1, 0, 0), 4, 6},
+		{NewPoint(0.5, 5, 0), NewVector(0, -1, 0), 4, 6},
+		{NewPoint(0.5, -5, 0), NewVector(0, 1, 0), 4, 6},
+		{NewPoint(0.5, 0, 5), NewVector(0, 0, -1), 4, 6},
+		{NewPoint(0.5, 0, -5), NewVector(0, 0, 1), 4, 6},
+		{NewPoint(0, 0.5, 0), NewVector(0, 0, 1), -1, 1},
+	}
+
+	for _, e := range examples {
+		xs := c.LocalIntersect(NewRay(e.origin, e.direction))
+
+		assert.Len(t, xs, 2)
+		assert.Equal(t, e.t1, xs[0].T)
+		assert.Equal(t, e.t2, xs[1].T)
+	}
+}
+
+// Scenario Outline: A ray misses a cube
+// Given c ← cube()
+// And r ← ray(<origin>, <direction>)
+// When xs ← local_intersect(c, r)
+// Then xs.count = 0
+//
+// Examples:
+// | origin | direction |
+// | point(-2, 0, 0) | vector(0.2673, 0.5345, 0.8018) |
+// | point(0, -2, 0) | vector(0.8018, 0.2673, 0.5345) |
+// | point(0, 0, -2) | vector(0.5345, 0.8018, 0.2673) |
+// | point(2, 0, 2) | vector(0, 0, -1) |
+// | point(0, 2, 2) | vector(0, -1, 0) |
+// | point(2, 2, 0) | vector(-1, 0, 0) |
+func TestCubeIntersectMiss(t *testing.T) {
+	c := NewCube()
+
+	examples := []struct {
+		origin    *Point
+		direction *Vector
+	}{
+		{NewPoint(-2, 0, 0), NewVector(0.2673, 0.5345, 0.8018)},
+		{NewPoint(0, -2, 0), NewVector(0.8018, 0.2673, 0.5345)},
+		{NewPoint(0, 0, -2), NewVector(0.5345, 0.8018, 0.2673)},
+		{NewPoint(2, 0, 2), NewVector(0, 0, -1)},
+		{NewPoint(0, 2, 2), NewVector(0, -1, 0)},
+		{NewPoint(2, 2, 0), NewVector(-1, 0, 0)},
+	}
+
+	for _, e := range examples {
+		xs := c.LocalIntersect(NewRay(e.origin, e.direction))
+
+		assert.Len(t, xs, 0)
+	}
+}
+
+// Scenario Outline: The normal on the surface of a cube
+// Given c ← cube()
+// And p ← <point>
+// When normal ← local_normal_at(c, p)
+// Then normal = <normal>
+//
+// Examples:
+// | point | normal |
+// | point(1, 0.5, -0.8) | vector(1, 0, 0) |
+// | point(-1, -0.2, 0.9) | vector(-1, 0, 0) |
+// | point(-0.4, 1, -0.1) | vector(0, 1, 0) |
+// | point(0.3, -1, -0.7) | vector(0, -1, 0) |
+// | point(-0.6, 0.3, 1) | vector(0, 0, 1) |
+// | point(0.4, 0.4, -1) | vector(0, 0, -1) |
+// | point(1, 1, 1) | vector(1, 0, 0) |
+// | point(-1, -1, -1) | vector(-1, 0, 0) |
+func TestCubeNormal(t *testing.T) {
+	c := NewCube()
+
+	examples := []struct {
+		point  *Point
+		normal *Vector
+	}{
+		{NewPoint(1, 0.5, -0.8), NewVector(1, 0, 0)},
+		{NewPoint(-1, -0.2, 0.9), NewVector(-1, 0, 0)},
+		{NewPoint(-0.4, 1, -0.1), NewVector(0, 1, 0)},
+		{NewPoint(0.3, -1, -0.7), NewVector(0, -1, 0)},
+		{NewPoint(-0.6, 0.3, 1), NewVector(0, 0, 1)},
+		{NewPoint(0.4, 0.4, -1), NewVector(0, 0, -1)},
+		{NewPoint(1, 1, 1), NewVector(1, 0, 0)},
+		{NewPoint(-1, -1, -1), NewVector(-1, 0, 0)},
+	}
+
+	for _, e := range examples {
+		assert.True(t, e.normal.Equals(c.LocalNormalAt(e.point, nil)))
+	}
+}
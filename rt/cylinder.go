@@ -0,0 +1,100 @@
+package rt
+
+import "math"
+
+// Cylinder is a radius 1 cylinder around the y axis in object space. It is
+// infinitely long unless Minimum and Maximum are set, and is hollow unless
+// Closed is set, which caps both ends.
+type Cylinder struct {
+	BaseShape
+	Minimum float64
+	Maximum float64
+	Closed  bool
+}
+
+func NewCylinder() *Cylinder {
+	return &Cylinder{
+		BaseShape: NewBaseShape(),
+		Minimum:   math.Inf(-1),
+		Maximum:   math.Inf(1),
+		Closed:    false,
+	}
+}
+
+// checkCap checks if the intersection at t is within radius of the y axis
+func checkCap(r *Ray, t float64, radius float64) bool {
+	x := r.Origin.X + t*r.Direction.X
+	z := r.Origin.Z + t*r.Direction.Z
+	return (x*x + z*z) <= radius*radius
+}
+
+func (c *Cylinder) intersectCaps(r *Ray, xs Intersections) Intersections {
+
+	// Caps only matter when the cylinder is closed and the ray isn't
+	// parallel to them
+	if !c.Closed || math.Abs(r.Direction.Y) < SMALL_NUMBER_F64 {
+		return xs
+	}
+
+	t := (c.Minimum - r.Origin.Y) / r.Direction.Y
+	if checkCap(r, t, 1) {
+		xs = append(xs, NewIntersection(t, c))
+	}
+
+	t = (c.Maximum - r.Origin.Y) / r.Direction.Y
+	if checkCap(r, t, 1) {
+		xs = append(xs, NewIntersection(t, c))
+	}
+
+	return xs
+}
+
+func (c *Cylinder) LocalIntersect(r *Ray) Intersections {
+	xs := Intersections{}
+
+	a := r.Direction.X*r.Direction.X + r.Direction.Z*r.Direction.Z
+
+	// A ray parallel to the y axis can only hit the caps
+	if math.Abs(a) >= SMALL_NUMBER_F64 {
+		b := 2*r.Origin.X*r.Direction.X + 2*r.Origin.Z*r.Direction.Z
+		cc := r.Origin.X*r.Origin.X + r.Origin.Z*r.Origin.Z - 1
+
+		disc := b*b - 4*a*cc
+
+		if disc < 0 {
+			return xs
+		}
+
+		t0 := (-b - math.Sqrt(disc)) / (2 * a)
+		t1 := (-b + math.Sqrt(disc)) / (2 * a)
+
+		y0 := r.Origin.Y + t0*r.Direction.Y
+		if c.Minimum < y0 && y0 < c.Maximum {
+			xs = append(xs, NewIntersection(t0, c))
+		}
+
+		y1 := r.Origin.Y + t1*r.Direction.Y
+		if c.Minimum < y1 && y1 < c.Maximum {
+			xs = append(xs, NewIntersection(t1, c))
+		}
+	}
+
+	xs = c.intersectCaps(r, xs)
+	xs.Sort()
+
+	return xs
+}
+
+func (c *Cylinder) LocalNormalAt(p *Point, hit *Intersection) *Vector {
+	dist := p.X*p.X + p.Z*p.Z
+
+	if dist < 1 && p.Y >= c.Maximum-SMALL_NUMBER_F64 {
+		return NewVector(0, 1, 0)
+	}
+
+	if dist < 1 && p.Y <= c.Minimum+SMALL_NUMBER_F64 {
+		return NewVector(0, -1, 0)
+	}
+
+	return NewVector(p.X, 0, p.Z)
+}
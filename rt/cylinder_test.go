@@ -0,0 +1,243 @@
+package rt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario Outline: A ray misses a cylinder
+// Given cyl ← cylinder()
+// And direction ← normalize(<direction>)
+// And r ← ray(<origin>, direction)
+// When xs ← local_intersect(cyl, r)
+// Then xs.count = 0
+//
+// Examples:
+// | origin | direction |
+// | point(1, 0, 0) | vector(0, 1, 0) |
+// | point(0, 0, 0) | vector(0, 1, 0) |
+// | point(0, 0, -5) | vector(1, 1, 1) |
+func TestCylinderIntersectMiss(t *testing.T) {
+	c := NewCylinder()
+
+	examples := []struct {
+		origin    *Point
+		direction *Vector
+	}{
+		{NewPoint(1, 0, 0), NewVector(0, 1, 0)},
+		{NewPoint(0, 0, 0), NewVector(0, 1, 0)},
+		{NewPoint(0, 0, -5), NewVector(1, 1, 1)},
+	}
+
+	for _, e := range examples {
+		xs := c.LocalIntersect(NewRay(e.origin, e.direction.Norm()))
+
+		assert.Len(t, xs, 0)
+	}
+}
+
+// Scenario Outline: A ray strikes a cylinder
+// Given cyl ← cylinder()
+// And direction ← normalize(<direction>)
+// And r ← ray(<origin>, direction)
+// When xs ← local_intersect(cyl, r)
+// Then xs.count = 2
+// And xs[0].t = <t0>
+// And xs[1].t = <t1>
+//
+// Examples:
+// | origin | direction | t0 | t1 |
+// | point(1, 0, -5) | vector(0, 0, 1) | 5 | 5 |
+// | point(0, 0, -5) | vector(0, 0, 1) | 4 | 6 |
+// | point(0.5, 0, -5) | vector(0.1, 1, 1) | 6.80798 | 7.08872 |
+func TestCylinderIntersect(t *testing.T) {
+	c := NewCylinder()
+
+	examples := []struct {
+		origin    *Point
+		direction *Vector
+		t0, t1    float64
+	}{
+		{NewPoint(1, 0, -5), NewVector(0, 0, 1), 5, 5},
+		{NewPoint(0, 0, -5), NewVector(0, 0, 1), 4, 6},
+		{NewPoint(0.5, 0, -5), NewVector(0.1, 1, 1), 6.80798, 7.08872},
+	}
+
+	for _, e := range examples {
+		xs := c.LocalIntersect(NewRay(e.origin, e.direction.Norm()))
+
+		assert.Len(t, xs, 2)
+		assert.InDelta(t, e.t0, xs[0].T, 0.00001)
+		assert.InDelta(t, e.t1, xs[1].T, 0.00001)
+	}
+}
+
+// Scenario Outline: Normal vector on a cylinder
+// Given cyl ← cylinder()
+// When n ← local_normal_at(cyl, <point>)
+// Then n = <normal>
+//
+// Examples:
+// | point | normal |
+// | point(1, 0, 0) | vector(1, 0, 0) |
+// | point(0, 5, -1) | vector(0, 0, -1) |
+// | point(0, -2, 1) | vector(0, 0, 1) |
+// | point(-1, 1, 0) | vector(-1, 0, 0) |
+func TestCylinderNormal(t *testing.T) {
+	c := NewCylinder()
+
+	examples := []struct {
+		point  *Point
+		normal *Vector
+	}{
+		{NewPoint(1, 0, 0), NewVector(1, 0, 0)},
+		{NewPoint(0, 5, -1), NewVector(0, 0, -1)},
+		{NewPoint(0, -2, 1), NewVector(0, 0, 1)},
+		{NewPoint(-1, 1, 0), NewVector(-1, 0, 0)},
+	}
+
+	for _, e := range examples {
+		assert.True(t, e.normal.Equals(c.LocalNormalAt(e.point, nil)))
+	}
+}
+
+// Scenario: The default minimum and maximum for a cylinder
+// Given cyl ← cylinder()
+// Then cyl.minimum = -infinity
+// And cyl.maximum = infinity
+
+// Scenario: The default closed value for a cylinder
+// Given cyl ← cylinder()
+// Then cyl.closed = false
+func TestCylinderDefaults(t *testing.T) {
+	c := NewCylinder()
+
+	assert.True(t, math.IsInf(c.Minimum, -1))
+	assert.True(t, math.IsInf(c.Maximum, 1))
+	assert.False(t, c.Closed)
+}
+
+// Scenario Outline: Intersecting a constrained cylinder
+// Given cyl ← cylinder()
+// And cyl.minimum ← 1
+// And cyl.maximum ← 2
+// And direction ← normalize(<direction>)
+// And r ← ray(<point>, direction)
+// When xs ← local_intersect(cyl, r)
+// Then xs.count = <count>
+//
+// Examples:
+// | | point | direction | count |
+// | 1 | point(0, 1.5, 0) | vector(0.1, 1, 0) | 0 |
+// | 2 | point(0, 3, -5) | vector(0, 0, 1) | 0 |
+// | 3 | point(0, 0, -5) | vector(0, 0, 1) | 0 |
+// | 4 | point(0, 2, -5) | vector(0, 0, 1) | 0 |
+// | 5 | point(0, 1, -5) | vector(0, 0, 1) | 0 |
+// | 6 | point(0, 1.5, -2) | vector(0, 0, 1) | 2 |
+func TestCylinderIntersectConstrained(t *testing.T) {
+	c := NewCylinder()
+	c.Minimum = 1
+	c.Maximum = 2
+
+	examples := []struct {
+		point     *Point
+		direction *Vector
+		count     int
+	}{
+		{NewPoint(0, 1.5, 0), NewVector(0.1, 1, 0), 0},
+		{NewPoint(0, 3, -5), NewVector(0, 0, 1), 0},
+		{NewPoint(0, 0, -5), NewVector(0, 0, 1), 0},
+		{NewPoint(0, 2, -5), NewVector(0, 0, 1), 0},
+		{NewPoint(0, 1, -5), NewVector(0, 0, 1), 0},
+		{NewPoint(0, 1.5, -2), NewVector(0, 0, 1), 2},
+	}
+
+	for _, e := range examples {
+		xs := c.LocalIntersect(NewRay(e.point, e.direction.Norm()))
+
+		assert.Len(t, xs, e.count)
+	}
+}
+
+// Scenario Outline: Intersecting the caps of a closed cylinder
+// Given cyl ← cylinder()
+// And cyl.minimum ← 1
+// And cyl.maximum ← 2
+// And cyl.closed ← true
+// And direction ← normalize(<direction>)
+// And r ← ray(<point>, direction)
+// When xs ← local_intersect(cyl, r)
+// Then xs.count = <count>
+//
+// Examples:
+// | | point | direction | count |
+// | 1 | point(0, 3, 0) | vector(0, -1, 0) | 2 |
+// | 2 | point(0, 3, -2) | vector(0, -1, 2) | 2 |
+// | 3 | point(0, 4, -2) | vector(0, -1, 1) | 2 | # corner case
+// | 4 | point(0, 0, -2) | vector(0, 1, 2) | 2 |
+// | 5 | point(0, -1, -2) | vector(0, 1, 1) | 2 | # corner case
+func TestCylinderIntersectCaps(t *testing.T) {
+	c := NewCylinder()
+	c.Minimum = 1
+	c.Maximum = 2
+	c.Closed = true
+
+	examples := []struct {
+		point     *Point
+		direction *Vector
+		count     int
+	}{
+		{NewPoint(0, 3, 0), NewVector(0, -1, 0), 2},
+		{NewPoint(0, 3, -2), NewVector(0, -1, 2), 2},
+		{NewPoint(0, 4, -2), NewVector(0, -1, 1), 2},
+		{NewPoint(0, 0, -2), NewVector(0, 1, 2), 2},
+		{NewPoint(0, -1, -2), NewVector(0, 1, 1), 2},
+	}
+
+	for _, e := range examples {
+		xs := c.LocalIntersect(NewRay(e.point, e.direction.Norm()))
+
+		assert.Len(t, xs, e.count)
+	}
+}
+
+// Scenario Outline: The normal vector on a cylinder's end caps
+// Given cyl ← cylinder()
+// And cyl.minimum ← 1
+// And cyl.maximum ← 2
+// And cyl.closed ← true
+// When n ← local_normal_at(cyl, <point>)
+// Then n = <normal>
+//
+// Examples:
+// | point | normal |
+// | point(0, 1, 0) | vector(0, -1, 0) |
+// | point(0.5, 1, 0) | vector(0, -1, 0) |
+// | point(0, 1, 0.5) | vector(0, -1, 0) |
+// | point(0, 2, 0) | vector(0, 1, 0) |
+// | point(0.5, 2, 0) | vector(0, 1, 0) |
+// | point(0, 2, 0.5) | vector(0, 1, 0) |
+func TestCylinderNormalCaps(t *testing.T) {
+	c := NewCylinder()
+	c.Minimum = 1
+	c.Maximum = 2
+	c.Closed = true
+
+	examples := []struct {
+		point  *Point
+		normal *Vector
+	}{
+		{NewPoint(0, 1, 0), NewVector(0, -1, 0)},
+		{NewPoint(0.5, 1, 0), NewVector(0, -1, 0)},
+		{NewPoint(0, 1, 0.5), NewVector(0, -1, 0)},
+		{NewPoint(0, 2, 0), NewVector(0, 1, 0)},
+		{NewPoint(0.5, 2, 0), NewVector(0, 1, 0)},
+		{NewPoint(0, 2, 0.5), NewVector(0, 1, 0)},
+	}
+
+	for _, e := range examples {
+		assert.True(t, e.normal.Equals(c.LocalNormalAt(e.point, nil)))
+	}
+}
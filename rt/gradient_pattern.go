@@ -0,0 +1,32 @@
+package rt
+
+import "math"
+
+// GradientPattern blends from A to B across each unit along x
+type GradientPattern struct {
+	BasePattern
+	A Pattern
+	B Pattern
+}
+
+func NewGradientPattern(a, b *Color) *GradientPattern {
+	return NewNestedGradientPattern(NewSolidPattern(a), NewSolidPattern(b))
+}
+
+// NewNestedGradientPattern creates a gradient between two other patterns
+func NewNestedGradientPattern(a, b Pattern) *GradientPattern {
+	return &GradientPattern{
+		BasePattern: NewBasePattern(),
+		A:           a,
+		B:           b,
+	}
+}
+
+func (g *GradientPattern) LocalPatternAt(p *Point) *Color {
+	a := PatternAt(g.A, p)
+	d := PatternAt(g.B, p).Sub(a)
+	f := p.X - math.Floor(p.X)
+	c := a.Add(d.Multi(f))
+	c.W = 1
+	return c
+}
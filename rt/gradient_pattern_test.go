@@ -0,0 +1,22 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: A gradient linearly interpolates between colors
+// Given pattern ← gradient_pattern(white, black)
+// Then pattern_at(pattern, point(0, 0, 0)) = white
+// And pattern_at(pattern, point(0.25, 0, 0)) = color(0.75, 0.75, 0.75)
+// And pattern_at(pattern, point(0.5, 0, 0)) = color(0.5, 0.5, 0.5)
+// And pattern_at(pattern, point(0.75, 0, 0)) = color(0.25, 0.25, 0.25)
+func TestGradientPattern(t *testing.T) {
+	p := NewGradientPattern(patternWhite, patternBlack)
+
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0, 0))))
+	assert.True(t, NewColor(0.75, 0.75, 0.75, 1).Equals(p.LocalPatternAt(NewPoint(0.25, 0, 0))))
+	assert.True(t, NewColor(0.5, 0.5, 0.5, 1).Equals(p.LocalPatternAt(NewPoint(0.5, 0, 0))))
+	assert.True(t, NewColor(0.25, 0.25, 0.25, 1).Equals(p.LocalPatternAt(NewPoint(0.75, 0, 0))))
+}
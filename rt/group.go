@@ -0,0 +1,38 @@
+package rt
+
+// Group is a shape made up of other shapes, its transform applies to all
+// of its children
+type Group struct {
+	BaseShape
+	Children []Shape
+}
+
+func NewGroup() *Group {
+	return &Group{
+		BaseShape: NewBaseShape(),
+		Children:  []Shape{},
+	}
+}
+
+// AddChild adds the shapes to the group, making it their parent
+func (g *Group) AddChild(s ...Shape) {
+	for _, c := range s {
+		c.SetParent(g)
+		g.Children = append(g.Children, c)
+	}
+}
+
+func (g *Group) LocalIntersect(r *Ray) Intersections {
+	xs := Intersections{}
+	for _, c := range g.Children {
+		xs = append(xs, Intersect(c, r)...)
+	}
+	xs.Sort()
+	return xs
+}
+
+// LocalNormalAt should never be called, normals are always found on the
+// children a ray actually hits
+func (g *Group) LocalNormalAt(p *Point, hit *Intersection) *Vector {
+	panic("groups do not have normals, normal_at should be called on a child")
+}
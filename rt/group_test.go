@@ -0,0 +1,207 @@
+package rt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: Creating a new group
+// Given g ← group()
+// Then g.transform = identity_matrix
+// And g is empty
+func TestGroupNew(t *testing.T) {
+	g := NewGroup()
+
+	assert.True(t, g.Transform().Equal(m4i))
+	assert.Len(t, g.Children, 0)
+}
+
+// Scenario: Adding a child to a group
+// Given g ← group()
+// And s ← test_shape()
+// When add_child(g, s)
+// Then g is not empty
+// And g includes s
+// And s.parent = g
+func TestGroupAddChild(t *testing.T) {
+	g := NewGroup()
+	s := newTestShape()
+
+	g.AddChild(s)
+
+	assert.Len(t, g.Children, 1)
+	assert.Same(t, s, g.Children[0])
+	assert.Same(t, g, s.Parent())
+}
+
+// Scenario: Intersecting a ray with an empty group
+// Given g ← group()
+// And r ← ray(point(0, 0, 0), vector(0, 0, 1))
+// When xs ← local_intersect(g, r)
+// Then xs is empty
+func TestGroupIntersectEmpty(t *testing.T) {
+	g := NewGroup()
+
+	xs := g.LocalIntersect(NewRay(NewPoint(0, 0, 0), NewVector(0, 0, 1)))
+
+	assert.Len(t, xs, 0)
+}
+
+// Scenario: Intersecting a ray with a nonempty group
+// Given g ← group()
+// And s1 ← sphere()
+// And s2 ← sphere()
+// And set_transform(s2, translation(0, 0, -3))
+// And s3 ← sphere()
+// And set_transform(s3, translation(5, 0, 0))
+// And add_child(g, s1)
+// And add_child(g, s2)
+// And add_child(g, s3)
+// When r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And xs ← local_intersect(g, r)
+// Then xs.count = 4
+// And xs[0].object = s2
+// And xs[1].object = s2
+// And xs[2].object = s1
+// And xs[3].object = s1
+func TestGroupIntersect(t *testing.T) {
+	g := NewGroup()
+	s1 := NewSphere()
+	s2 := NewSphere()
+	s2.SetTransform(NewTransform().Translate(0, 0, -3))
+	s3 := NewSphere()
+	s3.SetTransform(NewTransform().Translate(5, 0, 0))
+	g.AddChild(s1, s2, s3)
+
+	xs := g.LocalIntersect(NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1)))
+
+	assert.Len(t, xs, 4)
+	assert.Same(t, s2, xs[0].Object)
+	assert.Same(t, s2, xs[1].Object)
+	assert.Same(t, s1, xs[2].Object)
+	assert.Same(t, s1, xs[3].Object)
+}
+
+// Scenario: Intersecting a transformed group
+// Given g ← group()
+// And set_transform(g, scaling(2, 2, 2))
+// And s ← sphere()
+// And set_transform(s, translation(5, 0, 0))
+// And add_child(g, s)
+// When r ← ray(point(10, 0, -10), vector(0, 0, 1))
+// And xs ← intersect(g, r)
+// Then xs.count = 2
+func TestGroupIntersectTransformed(t *testing.T) {
+	g := NewGroup()
+	g.SetTransform(NewTransform().Scale(2, 2, 2))
+	s := NewSphere()
+	s.SetTransform(NewTransform().Translate(5, 0, 0))
+	g.AddChild(s)
+
+	xs := Intersect(g, NewRay(NewPoint(10, 0, -10), NewVector(0, 0, 1)))
+
+	assert.Len(t, xs, 2)
+}
+
+// Scenario: Converting a point from world to object space
+// Given g1 ← group()
+// And set_transform(g1, rotation_y(π/2))
+// And g2 ← group()
+// And set_transform(g2, scaling(2, 2, 2))
+// And add_child(g1, g2)
+// And s ← sphere()
+// And set_transform(s, translation(5, 0, 0))
+// And add_child(g2, s)
+// When p ← world_to_object(s, point(-2, 0, -10))
+// Then p = point(0, 0, -1)
+func TestGroupWorldToObject(t *testing.T) {
+	g1 := NewGroup()
+	g1.SetTransform(NewTransform().RotateY(math.Pi / 2))
+	g2 := NewGroup()
+	g2.SetTransform(NewTransform().Scale(2, 2, 2))
+	g1.AddChild(g2)
+	s := NewSphere()
+	s.SetTransform(NewTransform().Translate(5, 0, 0))
+	g2.AddChild(s)
+
+	p := WorldToObject(s, NewPoint(-2, 0, -10))
+
+	assert.True(t, NewPoint(0, 0, -1).Equals(p))
+}
+
+// Scenario: Converting a normal from object to world space
+// Given g1 ← group()
+// And set_transform(g1, rotation_y(π/2))
+// And g2 ← group()
+// And set_transform(g2, scaling(1, 2, 3))
+// And add_child(g1, g2)
+// And s ← sphere()
+// And set_transform(s, translation(5, 0, 0))
+// And add_child(g2, s)
+// When n ← normal_to_world(s, vector(√3/3, √3/3, √3/3))
+// Then n = vector(0.2857, 0.4286, -0.8571)
+func TestGroupNormalToWorld(t *testing.T) {
+	g1 := NewGroup()
+	g1.SetTransform(NewTransform().RotateY(math.Pi / 2))
+	g2 := NewGroup()
+	g2.SetTransform(NewTransform().Scale(1, 2, 3))
+	g1.AddChild(g2)
+	s := NewSphere()
+	s.SetTransform(NewTransform().Translate(5, 0, 0))
+	g2.AddChild(s)
+
+	v := math.Sqrt(3) / 3
+	n := NormalToWorld(s, NewVector(v, v, v))
+
+	assert.InDelta(t, 0.2857, n.X, 0.0001)
+	assert.InDelta(t, 0.4286, n.Y, 0.0001)
+	assert.InDelta(t, -0.8571, n.Z, 0.0001)
+}
+
+// Scenario: Finding the normal on a child object
+// Given g1 ← group()
+// And set_transform(g1, rotation_y(π/2))
+// And g2 ← group()
+// And set_transform(g2, scaling(1, 2, 3))
+// And add_child(g1, g2)
+// And s ← sphere()
+// And set_transform(s, translation(5, 0, 0))
+// And add_child(g2, s)
+// When n ← normal_at(s, point(1.7321, 1.1547, -5.5774))
+// Then n = vector(0.2857, 0.4286, -0.8571)
+func TestGroupChildNormal(t *testing.T) {
+	g1 := NewGroup()
+	g1.SetTransform(NewTransform().RotateY(math.Pi / 2))
+	g2 := NewGroup()
+	g2.SetTransform(NewTransform().Scale(1, 2, 3))
+	g1.AddChild(g2)
+	s := NewSphere()
+	s.SetTransform(NewTransform().Translate(5, 0, 0))
+	g2.AddChild(s)
+
+	n := NormalAt(s, NewPoint(1.7321, 1.1547, -5.5774), nil)
+
+	assert.InDelta(t, 0.2857, n.X, 0.0001)
+	assert.InDelta(t, 0.4286, n.Y, 0.0001)
+	assert.InDelta(t, -0.8571, n.Z, 0.0001)
+}
+
+// Transforms compose down the hierarchy when intersecting nested groups
+func TestGroupIntersectNested(t *testing.T) {
+	g1 := NewGroup()
+	g1.SetTransform(NewTransform().Translate(0, 0, 10))
+	g2 := NewGroup()
+	g2.SetTransform(NewTransform().Scale(2, 2, 2))
+	g1.AddChild(g2)
+	s := NewSphere()
+	g2.AddChild(s)
+
+	xs := Intersect(g1, NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1)))
+
+	assert.Len(t, xs, 2)
+	assert.True(t, Equal(13, xs[0].T))
+	assert.True(t, Equal(17, xs[1].T))
+	assert.Same(t, s, xs[0].Object)
+}
@@ -0,0 +1,50 @@
+package rt
+
+import "sort"
+
+// U and V are only set by shapes that need to know where on their
+// surface the intersection was, like triangles
+type Intersection struct {
+	T      float64
+	Object Shape
+	U, V   float64
+}
+
+// Intersections are always kept sorted by T, lowest first
+type Intersections []*Intersection
+
+func NewIntersection(t float64, object Shape) *Intersection {
+	return &Intersection{
+		T:      t,
+		Object: object,
+	}
+}
+
+func NewIntersectionWithUV(t float64, object Shape, u, v float64) *Intersection {
+	return &Intersection{
+		T:      t,
+		Object: object,
+		U:      u,
+		V:      v,
+	}
+}
+
+func NewIntersections(xs ...*Intersection) Intersections {
+	is := Intersections(xs)
+	is.Sort()
+	return is
+}
+
+func (xs Intersections) Sort() {
+	sort.SliceStable(xs, func(i, j int) bool { return xs[i].T < xs[j].T })
+}
+
+// Hit returns the lowest non-negative intersection, or nil if there isn't one
+func (xs Intersections) Hit() *Intersection {
+	for _, i := range xs {
+		if i.T >= 0 {
+			return i
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: An intersection encapsulates t and object
+// Given s ← sphere()
+// When i ← intersection(3.5, s)
+// Then i.t = 3.5
+// And i.object = s
+func TestIntersectionNew(t *testing.T) {
+	s := NewSphere()
+	i := NewIntersection(3.5, s)
+
+	assert.Equal(t, 3.5, i.T)
+	assert.Same(t, s, i.Object)
+}
+
+// Scenario: Aggregating intersections
+// Given s ← sphere()
+// And i1 ← intersection(1, s)
+// And i2 ← intersection(2, s)
+// When xs ← intersections(i1, i2)
+// Then xs.count = 2
+// And xs[0].t = 1
+// And xs[1].t = 2
+func TestIntersectionsAggregate(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(1, s)
+	i2 := NewIntersection(2, s)
+
+	xs := NewIntersections(i2, i1)
+
+	assert.Len(t, xs, 2)
+	assert.Equal(t, 1.0, xs[0].T)
+	assert.Equal(t, 2.0, xs[1].T)
+}
+
+// Scenario: The hit, when all intersections have positive t
+// Given s ← sphere()
+// And i1 ← intersection(1, s)
+// And i2 ← intersection(2, s)
+// And xs ← intersections(i2, i1)
+// When i ← hit(xs)
+// Then i = i1
+func TestIntersectionsHitPositive(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(1, s)
+	i2 := NewIntersection(2, s)
+	xs := NewIntersections(i2, i1)
+
+	assert.Same(t, i1, xs.Hit())
+}
+
+// Scenario: The hit, when some intersections have negative t
+// Given s ← sphere()
+// And i1 ← intersection(-1, s)
+// And i2 ← intersection(1, s)
+// And xs ← intersections(i2, i1)
+// When i ← hit(xs)
+// Then i = i2
+func TestIntersectionsHitSomeNegative(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(-1, s)
+	i2 := NewIntersection(1, s)
+	xs := NewIntersections(i2, i1)
+
+	assert.Same(t, i2, xs.Hit())
+}
+
+// Scenario: The hit, when all intersections have negative t
+// Given s ← sphere()
+// And i1 ← intersection(-2, s)
+// And i2 ← intersection(-1, s)
+// And xs ← intersections(i2, i1)
+// When i ← hit(xs)
+// Then i is nothing
+func TestIntersectionsHitAllNegative(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(-2, s)
+	i2 := NewIntersection(-1, s)
+	xs := NewIntersections(i2, i1)
+
+	assert.Nil(t, xs.Hit())
+}
+
+// Scenario: The hit is always the lowest nonnegative intersection
+// Given s ← sphere()
+// And i1 ← intersection(5, s)
+// And i2 ← intersection(7, s)
+// And i3 ← intersection(-3, s)
+// And i4 ← intersection(2, s)
+// And xs ← intersections(i1, i2, i3, i4)
+// When i ← hit(xs)
+// Then i = i4
+func TestIntersectionsHitLowestNonNegative(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(5, s)
+	i2 := NewIntersection(7, s)
+	i3 := NewIntersection(-3, s)
+	i4 := NewIntersection(2, s)
+	xs := NewIntersections(i1, i2, i3, i4)
+
+	assert.Same(t, i4, xs.Hit())
+}
+
+// Scenario: An intersection can encapsulate `u` and `v`
+// Given s ← triangle(point(0, 1, 0), point(-1, 0, 0), point(1, 0, 0))
+// When i ← intersection_with_uv(3.5, s, 0.2, 0.4)
+// Then i.u = 0.2
+// And i.v = 0.4
+func TestIntersectionWithUV(t *testing.T) {
+	s := NewTriangle(NewPoint(0, 1, 0), NewPoint(-1, 0, 0), NewPoint(1, 0, 0))
+
+	i := NewIntersectionWithUV(3.5, s, 0.2, 0.4)
+
+	assert.Equal(t, 3.5, i.T)
+	assert.Equal(t, 0.2, i.U)
+	assert.Equal(t, 0.4, i.V)
+}
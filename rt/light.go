@@ -0,0 +1,67 @@
+package rt
+
+import "math"
+
+type PointLight struct {
+	Position  *Point
+	Intensity *Color
+}
+
+func NewPointLight(position *Point, intensity *Color) *PointLight {
+	return &PointLight{
+		Position:  position,
+		Intensity: intensity,
+	}
+}
+
+// Lighting shades a point on a surface using the Phong reflection model.
+// Points in shadow only receive ambient light. The object is needed to
+// find where the point is on any pattern the material has.
+func Lighting(m *Material, object Shape, l *PointLight, p *Point, eyev, normalv *Vector, inShadow bool) *Color {
+
+	color := m.Color
+	if m.Pattern != nil {
+		color = PatternAtShape(m.Pattern, object, p)
+	}
+
+	// Combine the surface color with the light's color / intensity
+	ec := color.Prod(l.Intensity)
+
+	// Direction to the light source, point - point is a point so fix w
+	lv := l.Position.Sub(p)
+	lv.W = 0
+	lv = lv.Norm()
+
+	ambient := ec.Multi(m.Ambient)
+
+	if inShadow {
+		ambient.W = 1
+		return ambient
+	}
+
+	diffuse := NewColor(0, 0, 0, 1)
+	specular := NewColor(0, 0, 0, 1)
+
+	// A negative cosine between the light and normal means the light is
+	// on the other side of the surface
+	ldn := lv.Dot(normalv)
+	if ldn >= 0 {
+		diffuse = ec.Multi(m.Diffuse * ldn)
+
+		// A negative cosine between the reflection and eye means the light
+		// reflects away from the eye
+		nlv := lv.Neg()
+		rde := nlv.Reflect(normalv).Dot(eyev)
+		if rde > 0 {
+			f := math.Pow(rde, m.Shininess)
+			specular = l.Intensity.Multi(m.Specular * f)
+		}
+	}
+
+	c := ambient.Add(diffuse).Add(specular)
+
+	// Rendered colors are always opaque
+	c.W = 1
+
+	return c
+}
@@ -0,0 +1,172 @@
+package rt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: A point light has a position and intensity
+// Given intensity ← color(1, 1, 1)
+// And position ← point(0, 0, 0)
+// When light ← point_light(position, intensity)
+// Then light.position = position
+// And light.intensity = intensity
+func TestPointLightNew(t *testing.T) {
+	i := NewColor(1, 1, 1, 1)
+	p := NewPoint(0, 0, 0)
+
+	l := NewPointLight(p, i)
+
+	assert.True(t, p.Equals(l.Position))
+	assert.True(t, i.Equals(l.Intensity))
+}
+
+// Background:
+// Given m ← material()
+// And position ← point(0, 0, 0)
+// And object ← sphere()
+
+// Scenario: Lighting with the eye between the light and the surface
+// Given eyev ← vector(0, 0, -1)
+// And normalv ← vector(0, 0, -1)
+// And light ← point_light(point(0, 0, -10), color(1, 1, 1))
+// When result ← lighting(m, object, light, position, eyev, normalv)
+// Then result = color(1.9, 1.9, 1.9)
+func TestLightingEyeBetweenLightAndSurface(t *testing.T) {
+	m := NewMaterial()
+	p := NewPoint(0, 0, 0)
+	eyev := NewVector(0, 0, -1)
+	normalv := NewVector(0, 0, -1)
+	l := NewPointLight(NewPoint(0, 0, -10), NewColor(1, 1, 1, 1))
+
+	r := Lighting(m, NewSphere(), l, p, eyev, normalv, false)
+
+	assert.True(t, NewColor(1.9, 1.9, 1.9, 1).Equals(r))
+}
+
+// Scenario: Lighting with the eye between light and surface, eye offset 45°
+// Given eyev ← vector(0, √2/2, -√2/2)
+// And normalv ← vector(0, 0, -1)
+// And light ← point_light(point(0, 0, -10), color(1, 1, 1))
+// When result ← lighting(m, object, light, position, eyev, normalv)
+// Then result = color(1.0, 1.0, 1.0)
+func TestLightingEyeOffset45(t *testing.T) {
+	m := NewMaterial()
+	p := NewPoint(0, 0, 0)
+	eyev := NewVector(0, math.Sqrt2/2, -math.Sqrt2/2)
+	normalv := NewVector(0, 0, -1)
+	l := NewPointLight(NewPoint(0, 0, -10), NewColor(1, 1, 1, 1))
+
+	r := Lighting(m, NewSphere(), l, p, eyev, normalv, false)
+
+	assert.True(t, NewColor(1.0, 1.0, 1.0, 1).Equals(r))
+}
+
+// Scenario: Lighting with eye opposite surface, light offset 45°
+// Given eyev ← vector(0, 0, -1)
+// And normalv ← vector(0, 0, -1)
+// And light ← point_light(point(0, 10, -10), color(1, 1, 1))
+// When result ← lighting(m, object, light, position, eyev, normalv)
+// Then result = color(0.7364, 0.7364, 0.7364)
+func TestLightingLightOffset45(t *testing.T) {
+	m := NewMaterial()
+	p := NewPoint(0, 0, 0)
+	eyev := NewVector(0, 0, -1)
+	normalv := NewVector(0, 0, -1)
+	l := NewPointLight(NewPoint(0, 10, -10), NewColor(1, 1, 1, 1))
+
+	r := Lighting(m, NewSphere(), l, p, eyev, normalv, false)
+
+	assert.InDelta(t, 0.7364, r.X, 0.0001)
+	assert.InDelta(t, 0.7364, r.Y, 0.0001)
+	assert.InDelta(t, 0.7364, r.Z, 0.0001)
+}
+
+// Scenario: Lighting with eye in the path of the reflection vector
+// Given eyev ← vector(0, -√2/2, -√2/2)
+// And normalv ← vector(0, 0, -1)
+// And light ← point_light(point(0, 10, -10), color(1, 1, 1))
+// When result ← lighting(m, object, light, position, eyev, normalv)
+// Then result = color(1.6364, 1.6364, 1.6364)
+func TestLightingEyeInReflectionPath(t *testing.T) {
+	m := NewMaterial()
+	p := NewPoint(0, 0, 0)
+	eyev := NewVector(0, -math.Sqrt2/2, -math.Sqrt2/2)
+	normalv := NewVector(0, 0, -1)
+	l := NewPointLight(NewPoint(0, 10, -10), NewColor(1, 1, 1, 1))
+
+	r := Lighting(m, NewSphere(), l, p, eyev, normalv, false)
+
+	assert.InDelta(t, 1.6364, r.X, 0.0001)
+	assert.InDelta(t, 1.6364, r.Y, 0.0001)
+	assert.InDelta(t, 1.6364, r.Z, 0.0001)
+}
+
+// Scenario: Lighting with the light behind the surface
+// Given eyev ← vector(0, 0, -1)
+// And normalv ← vector(0, 0, -1)
+// And light ← point_light(point(0, 0, 10), color(1, 1, 1))
+// When result ← lighting(m, object, light, position, eyev, normalv)
+// Then result = color(0.1, 0.1, 0.1)
+func TestLightingLightBehindSurface(t *testing.T) {
+	m := NewMaterial()
+	p := NewPoint(0, 0, 0)
+	eyev := NewVector(0, 0, -1)
+	normalv := NewVector(0, 0, -1)
+	l := NewPointLight(NewPoint(0, 0, 10), NewColor(1, 1, 1, 1))
+
+	r := Lighting(m, NewSphere(), l, p, eyev, normalv, false)
+
+	assert.True(t, NewColor(0.1, 0.1, 0.1, 1).Equals(r))
+}
+
+// Scenario: Lighting with the surface in shadow
+// Given eyev ← vector(0, 0, -1)
+// And normalv ← vector(0, 0, -1)
+// And light ← point_light(point(0, 0, -10), color(1, 1, 1))
+// And in_shadow ← true
+// When result ← lighting(m, object, light, position, eyev, normalv, in_shadow)
+// Then result = color(0.1, 0.1, 0.1)
+func TestLightingInShadow(t *testing.T) {
+	m := NewMaterial()
+	p := NewPoint(0, 0, 0)
+	eyev := NewVector(0, 0, -1)
+	normalv := NewVector(0, 0, -1)
+	l := NewPointLight(NewPoint(0, 0, -10), NewColor(1, 1, 1, 1))
+
+	r := Lighting(m, NewSphere(), l, p, eyev, normalv, true)
+
+	assert.True(t, NewColor(0.1, 0.1, 0.1, 1).Equals(r))
+}
+
+// Scenario: Lighting with a pattern applied
+// Given m.pattern ← stripe_pattern(color(1, 1, 1), color(0, 0, 0))
+// And m.ambient ← 1
+// And m.diffuse ← 0
+// And m.specular ← 0
+// And eyev ← vector(0, 0, -1)
+// And normalv ← vector(0, 0, -1)
+// And light ← point_light(point(0, 0, -10), color(1, 1, 1))
+// When c1 ← lighting(m, object, light, point(0.9, 0, 0), eyev, normalv, false)
+// And c2 ← lighting(m, object, light, point(1.1, 0, 0), eyev, normalv, false)
+// Then c1 = color(1, 1, 1)
+// And c2 = color(0, 0, 0)
+func TestLightingPattern(t *testing.T) {
+	m := NewMaterial()
+	m.Pattern = NewStripePattern(NewColor(1, 1, 1, 1), NewColor(0, 0, 0, 1))
+	m.Ambient = 1
+	m.Diffuse = 0
+	m.Specular = 0
+	eyev := NewVector(0, 0, -1)
+	normalv := NewVector(0, 0, -1)
+	l := NewPointLight(NewPoint(0, 0, -10), NewColor(1, 1, 1, 1))
+	s := NewSphere()
+
+	c1 := Lighting(m, s, l, NewPoint(0.9, 0, 0), eyev, normalv, false)
+	c2 := Lighting(m, s, l, NewPoint(1.1, 0, 0), eyev, normalv, false)
+
+	assert.True(t, NewColor(1, 1, 1, 1).Equals(c1))
+	assert.True(t, NewColor(0, 0, 0, 1).Equals(c2))
+}
@@ -0,0 +1,31 @@
+package rt
+
+type Material struct {
+	Color     *Color
+	Ambient   float64
+	Diffuse   float64
+	Specular  float64
+	Shininess float64
+	// Reflective is how much of the surrounding scene is mirrored, from
+	// 0 for not at all to 1 for a perfect mirror
+	Reflective float64
+	// Transparency is how much light passes through, from 0 for opaque to 1
+	Transparency float64
+	// RefractiveIndex is how much light bends entering the material, 1 for a vacuum
+	RefractiveIndex float64
+	// Pattern colors the surface in place of Color when set
+	Pattern Pattern
+}
+
+func NewMaterial() *Material {
+	return &Material{
+		Color:           NewColor(1, 1, 1, 1),
+		Ambient:         0.1,
+		Diffuse:         0.9,
+		Specular:        0.9,
+		Shininess:       200.0,
+		Reflective:      0.0,
+		Transparency:    0.0,
+		RefractiveIndex: 1.0,
+	}
+}
@@ -0,0 +1,30 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: The default material
+// Given m ← material()
+// Then m.color = color(1, 1, 1)
+// And m.ambient = 0.1
+// And m.diffuse = 0.9
+// And m.specular = 0.9
+// And m.shininess = 200.0
+// And m.reflective = 0.0
+// And m.transparency = 0.0
+// And m.refractive_index = 1.0
+func TestMaterialDefault(t *testing.T) {
+	m := NewMaterial()
+
+	assert.True(t, NewColor(1, 1, 1, 1).Equals(m.Color))
+	assert.Equal(t, 0.1, m.Ambient)
+	assert.Equal(t, 0.9, m.Diffuse)
+	assert.Equal(t, 0.9, m.Specular)
+	assert.Equal(t, 200.0, m.Shininess)
+	assert.Equal(t, 0.0, m.Reflective)
+	assert.Equal(t, 0.0, m.Transparency)
+	assert.Equal(t, 1.0, m.RefractiveIndex)
+}
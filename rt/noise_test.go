@@ -0,0 +1,42 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Noise is 0 at every lattice point
+func TestNoiseLattice(t *testing.T) {
+	for _, p := range [][3]float64{{0, 0, 0}, {1, 2, 3}, {-4, 7, -1}, {255, 256, 512}} {
+		assert.Equal(t, 0.0, Noise(p[0], p[1], p[2]), "%v", p)
+	}
+}
+
+// Noise stays in range, varies between points and always gives the same
+// value for the same point
+func TestNoiseRange(t *testing.T) {
+	seen := map[float64]bool{}
+
+	for x := -2.0; x < 2; x += 0.37 {
+		for y := -2.0; y < 2; y += 0.41 {
+			for z := -2.0; z < 2; z += 0.43 {
+				n := Noise(x, y, z)
+				assert.GreaterOrEqual(t, n, -1.0)
+				assert.LessOrEqual(t, n, 1.0)
+				assert.Equal(t, n, Noise(x, y, z))
+				seen[n] = true
+			}
+		}
+	}
+
+	assert.Greater(t, len(seen), 100)
+}
+
+// Noise is smooth, nearby points have nearby values
+func TestNoiseContinuous(t *testing.T) {
+	a := Noise(0.5, 0.25, 0.75)
+	b := Noise(0.5001, 0.25, 0.75)
+
+	assert.InDelta(t, a, b, 0.001)
+}
@@ -0,0 +1,254 @@
+package rt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ObjParser holds the result of reading a Wavefront OBJ file. Faces before
+// any `g` statement go into DefaultGroup, the rest into their named group.
+type ObjParser struct {
+	Vertices      []*Point
+	Normals       []*Vector
+	TextureCoords []*Tuple
+	DefaultGroup  *Group
+	Groups        map[string]*Group
+	Ignored       int
+
+	groupNames []string
+	current    *Group
+}
+
+// objVertex is a single v/vt/vn reference from a face, -1 if unset
+type objVertex struct {
+	v, vt, vn int
+}
+
+func newObjParser() *ObjParser {
+	p := ObjParser{
+		Vertices:      []*Point{},
+		Normals:       []*Vector{},
+		TextureCoords: []*Tuple{},
+		DefaultGroup:  NewGroup(),
+		Groups:        map[string]*Group{},
+		groupNames:    []string{},
+	}
+	p.current = p.DefaultGroup
+	return &p
+}
+
+// ParseObj reads an OBJ stream. Statements it doesn't understand are
+// skipped and counted in Ignored, malformed data it does understand
+// returns an error.
+func ParseObj(r io.Reader) (*ObjParser, error) {
+	p := newObjParser()
+
+	s := bufio.NewScanner(r)
+	line := 0
+	for s.Scan() {
+		line++
+
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		var err error
+
+		switch fields[0] {
+		case "v":
+			err = p.parseVertex(fields[1:])
+		case "vn":
+			err = p.parseNormal(fields[1:])
+		case "vt":
+			err = p.parseTextureCoord(fields[1:])
+		case "f":
+			err = p.parseFace(fields[1:])
+		case "g":
+			err = p.parseGroup(fields[1:])
+		default:
+			p.Ignored++
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("obj line %d: %w", line, err)
+		}
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// ToGroup returns a single group holding the default group and every
+// named group, in the order they appeared
+func (p *ObjParser) ToGroup() *Group {
+	g := NewGroup()
+	g.AddChild(p.DefaultGroup)
+	for _, n := range p.groupNames {
+		g.AddChild(p.Groups[n])
+	}
+	return g
+}
+
+func parseFloats(fields []string, min, max int) ([]float64, error) {
+	if len(fields) < min || len(fields) > max {
+		return nil, fmt.Errorf("expected %d to %d values, got %d", min, max, len(fields))
+	}
+
+	res := make([]float64, len(fields))
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", f)
+		}
+		res[i] = v
+	}
+	return res, nil
+}
+
+func (p *ObjParser) parseVertex(fields []string) error {
+	// w is optional, and ignored as we only deal with points
+	v, err := parseFloats(fields, 3, 4)
+	if err != nil {
+		return err
+	}
+	p.Vertices = append(p.Vertices, NewPoint(v[0], v[1], v[2]))
+	return nil
+}
+
+func (p *ObjParser) parseNormal(fields []string) error {
+	v, err := parseFloats(fields, 3, 3)
+	if err != nil {
+		return err
+	}
+	p.Normals = append(p.Normals, NewVector(v[0], v[1], v[2]))
+	return nil
+}
+
+func (p *ObjParser) parseTextureCoord(fields []string) error {
+	v, err := parseFloats(fields, 1, 3)
+	if err != nil {
+		return err
+	}
+	tc := NewTuple(0, 0, 0, 0)
+	tc.X = v[0]
+	if len(v) > 1 {
+		tc.Y = v[1]
+	}
+	if len(v) > 2 {
+		tc.Z = v[2]
+	}
+	p.TextureCoords = append(p.TextureCoords, tc)
+	return nil
+}
+
+func (p *ObjParser) parseGroup(fields []string) error {
+	if len(fields) == 0 {
+		return fmt.Errorf("group has no name")
+	}
+
+	name := strings.Join(fields, " ")
+
+	g, ok := p.Groups[name]
+	if !ok {
+		g = NewGroup()
+		p.Groups[name] = g
+		p.groupNames = append(p.groupNames, name)
+	}
+	p.current = g
+
+	return nil
+}
+
+// resolveIndex converts a 1 based, or negative relative, OBJ index into a
+// 0 based index into a list of length n
+func resolveIndex(s string, n int) (int, error) {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return -1, fmt.Errorf("invalid index %q", s)
+	}
+
+	if i < 0 {
+		i = n + i
+	} else {
+		i = i - 1
+	}
+
+	if i < 0 || i >= n {
+		return -1, fmt.Errorf("index %s out of range, only %d defined", s, n)
+	}
+
+	return i, nil
+}
+
+// parseFaceVertex reads a v, v/vt, v//vn or v/vt/vn face reference
+func (p *ObjParser) parseFaceVertex(f string) (objVertex, error) {
+	ov := objVertex{v: -1, vt: -1, vn: -1}
+	parts := strings.Split(f, "/")
+
+	if len(parts) > 3 {
+		return ov, fmt.Errorf("invalid face vertex %q", f)
+	}
+
+	var err error
+
+	if ov.v, err = resolveIndex(parts[0], len(p.Vertices)); err != nil {
+		return ov, err
+	}
+
+	if len(parts) > 1 && parts[1] != "" {
+		if ov.vt, err = resolveIndex(parts[1], len(p.TextureCoords)); err != nil {
+			return ov, err
+		}
+	}
+
+	if len(parts) > 2 && parts[2] != "" {
+		if ov.vn, err = resolveIndex(parts[2], len(p.Normals)); err != nil {
+			return ov, err
+		}
+	}
+
+	return ov, nil
+}
+
+// parseFace fan triangulates the polygon around its first vertex. If every
+// vertex has a normal the triangles are smooth.
+func (p *ObjParser) parseFace(fields []string) error {
+	if len(fields) < 3 {
+		return fmt.Errorf("face needs at least 3 vertices, got %d", len(fields))
+	}
+
+	verts := make([]objVertex, len(fields))
+	smooth := true
+	for i, f := range fields {
+		ov, err := p.parseFaceVertex(f)
+		if err != nil {
+			return err
+		}
+		verts[i] = ov
+		smooth = smooth && ov.vn >= 0
+	}
+
+	for i := 1; i < len(verts)-1; i++ {
+		a, b, c := verts[0], verts[i], verts[i+1]
+
+		if smooth {
+			p.current.AddChild(NewSmoothTriangle(
+				p.Vertices[a.v], p.Vertices[b.v], p.Vertices[c.v],
+				p.Normals[a.vn], p.Normals[b.vn], p.Normals[c.vn],
+			))
+		} else {
+			p.current.AddChild(NewTriangle(
+				p.Vertices[a.v], p.Vertices[b.v], p.Vertices[c.v],
+			))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,340 @@
+package rt
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: Ignoring unrecognized lines
+// Given gibberish ← a file containing:
+// """
+// There was a young lady named Bright
+// who traveled much faster than light.
+// She set out one day
+// in a relative way,
+// and came back the previous night.
+// """
+// When parser ← parse_obj_file(gibberish)
+// Then parser should have ignored 5 lines
+func TestObjIgnoreUnrecognized(t *testing.T) {
+	gibberish := `There was a young lady named Bright
+who traveled much faster than light.
+She set out one day
+in a relative way,
+and came back the previous night.`
+
+	p, err := ParseObj(strings.NewReader(gibberish))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, p.Ignored)
+}
+
+// Scenario: Vertex records
+// Given file ← a file containing:
+// """
+// v -1 1 0
+// v -1.0000 0.5000 0.0000
+// v 1 0 0
+// v 1 1 0
+// """
+// When parser ← parse_obj_file(file)
+// Then parser.vertices[1] = point(-1, 1, 0)
+// And parser.vertices[2] = point(-1, 0.5, 0)
+// And parser.vertices[3] = point(1, 0, 0)
+// And parser.vertices[4] = point(1, 1, 0)
+func TestObjVertices(t *testing.T) {
+	file := `v -1 1 0
+v -1.0000 0.5000 0.0000
+v 1 0 0
+v 1 1 0`
+
+	p, err := ParseObj(strings.NewReader(file))
+
+	assert.NoError(t, err)
+	assert.Len(t, p.Vertices, 4)
+	assert.True(t, NewPoint(-1, 1, 0).Equals(p.Vertices[0]))
+	assert.True(t, NewPoint(-1, 0.5, 0).Equals(p.Vertices[1]))
+	assert.True(t, NewPoint(1, 0, 0).Equals(p.Vertices[2]))
+	assert.True(t, NewPoint(1, 1, 0).Equals(p.Vertices[3]))
+}
+
+// Scenario: Parsing triangle faces
+// Given file ← a file containing:
+// """
+// v -1 1 0
+// v -1 0 0
+// v 1 0 0
+// v 1 1 0
+//
+// f 1 2 3
+// f 1 3 4
+// """
+// When parser ← parse_obj_file(file)
+// And g ← parser.default_group
+// And t1 ← first child of g
+// And t2 ← second child of g
+// Then t1.p1 = parser.vertices[1]
+// And t1.p2 = parser.vertices[2]
+// And t1.p3 = parser.vertices[3]
+// And t2.p1 = parser.vertices[1]
+// And t2.p2 = parser.vertices[3]
+// And t2.p3 = parser.vertices[4]
+func TestObjTriangleFaces(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+v 1 1 0
+
+f 1 2 3
+f 1 3 4`
+
+	p, err := ParseObj(strings.NewReader(file))
+	assert.NoError(t, err)
+
+	g := p.DefaultGroup
+	assert.Len(t, g.Children, 2)
+
+	t1 := g.Children[0].(*Triangle)
+	t2 := g.Children[1].(*Triangle)
+
+	assert.Same(t, p.Vertices[0], t1.P1)
+	assert.Same(t, p.Vertices[1], t1.P2)
+	assert.Same(t, p.Vertices[2], t1.P3)
+	assert.Same(t, p.Vertices[0], t2.P1)
+	assert.Same(t, p.Vertices[2], t2.P2)
+	assert.Same(t, p.Vertices[3], t2.P3)
+}
+
+// Scenario: Triangulating polygons
+// Given file ← a file containing:
+// """
+// v -1 1 0
+// v -1 0 0
+// v 1 0 0
+// v 1 1 0
+// v 0 2 0
+//
+// f 1 2 3 4 5
+// """
+// When parser ← parse_obj_file(file)
+// And g ← parser.default_group
+// And t1 ← first child of g
+// And t2 ← second child of g
+// And t3 ← third child of g
+// Then t1.p1 = parser.vertices[1]
+// And t1.p2 = parser.vertices[2]
+// And t1.p3 = parser.vertices[3]
+// And t2.p1 = parser.vertices[1]
+// And t2.p2 = parser.vertices[3]
+// And t2.p3 = parser.vertices[4]
+// And t3.p1 = parser.vertices[1]
+// And t3.p2 = parser.vertices[4]
+// And t3.p3 = parser.vertices[5]
+func TestObjTriangulatePolygons(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+v 1 1 0
+v 0 2 0
+
+f 1 2 3 4 5`
+
+	p, err := ParseObj(strings.NewReader(file))
+	assert.NoError(t, err)
+
+	g := p.DefaultGroup
+	assert.Len(t, g.Children, 3)
+
+	t1 := g.Children[0].(*Triangle)
+	t2 := g.Children[1].(*Triangle)
+	t3 := g.Children[2].(*Triangle)
+
+	assert.Same(t, p.Vertices[0], t1.P1)
+	assert.Same(t, p.Vertices[1], t1.P2)
+	assert.Same(t, p.Vertices[2], t1.P3)
+	assert.Same(t, p.Vertices[0], t2.P1)
+	assert.Same(t, p.Vertices[2], t2.P2)
+	assert.Same(t, p.Vertices[3], t2.P3)
+	assert.Same(t, p.Vertices[0], t3.P1)
+	assert.Same(t, p.Vertices[3], t3.P2)
+	assert.Same(t, p.Vertices[4], t3.P3)
+}
+
+// Scenario: Triangles in groups
+// Given file ← the file "triangles.obj"
+// When parser ← parse_obj_file(file)
+// And g1 ← "FirstGroup" from parser
+// And g2 ← "SecondGroup" from parser
+// And t1 ← first child of g1
+// And t2 ← first child of g2
+// Then t1.p1 = parser.vertices[1]
+// And t1.p2 = parser.vertices[2]
+// And t1.p3 = parser.vertices[3]
+// And t2.p1 = parser.vertices[1]
+// And t2.p2 = parser.vertices[3]
+// And t2.p3 = parser.vertices[4]
+func TestObjGroups(t *testing.T) {
+	f, err := os.Open("../files/triangles.obj")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	p, err := ParseObj(f)
+	assert.NoError(t, err)
+
+	g1 := p.Groups["FirstGroup"]
+	g2 := p.Groups["SecondGroup"]
+	assert.NotNil(t, g1)
+	assert.NotNil(t, g2)
+
+	t1 := g1.Children[0].(*Triangle)
+	t2 := g2.Children[0].(*Triangle)
+
+	assert.Same(t, p.Vertices[0], t1.P1)
+	assert.Same(t, p.Vertices[1], t1.P2)
+	assert.Same(t, p.Vertices[2], t1.P3)
+	assert.Same(t, p.Vertices[0], t2.P1)
+	assert.Same(t, p.Vertices[2], t2.P2)
+	assert.Same(t, p.Vertices[3], t2.P3)
+}
+
+// Scenario: Converting an OBJ file to a group
+// Given file ← the file "triangles.obj"
+// And parser ← parse_obj_file(file)
+// When g ← obj_to_group(parser)
+// Then g includes "FirstGroup" from parser
+// And g includes "SecondGroup" from parser
+func TestObjToGroup(t *testing.T) {
+	f, err := os.Open("../files/triangles.obj")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	p, err := ParseObj(f)
+	assert.NoError(t, err)
+
+	g := p.ToGroup()
+
+	assert.Contains(t, g.Children, p.Groups["FirstGroup"])
+	assert.Contains(t, g.Children, p.Groups["SecondGroup"])
+	assert.Same(t, g, p.Groups["FirstGroup"].Parent())
+}
+
+// Scenario: Vertex normal records
+// Given file ← a file containing:
+// """
+// vn 0 0 1
+// vn 0.707 0 -0.707
+// vn 1 2 3
+// """
+// When parser ← parse_obj_file(file)
+// Then parser.normals[1] = vector(0, 0, 1)
+// And parser.normals[2] = vector(0.707, 0, -0.707)
+// And parser.normals[3] = vector(1, 2, 3)
+func TestObjNormals(t *testing.T) {
+	file := `vn 0 0 1
+vn 0.707 0 -0.707
+vn 1 2 3`
+
+	p, err := ParseObj(strings.NewReader(file))
+
+	assert.NoError(t, err)
+	assert.True(t, NewVector(0, 0, 1).Equals(p.Normals[0]))
+	assert.True(t, NewVector(0.707, 0, -0.707).Equals(p.Normals[1]))
+	assert.True(t, NewVector(1, 2, 3).Equals(p.Normals[2]))
+}
+
+// Scenario: Faces with normals
+// Given file ← a file containing:
+// """
+// v 0 1 0
+// v -1 0 0
+// v 1 0 0
+//
+// vn -1 0 0
+// vn 1 0 0
+// vn 0 1 0
+//
+// f 1//3 2//1 3//2
+// f 1/0/3 2/102/1 3/14/2
+// """
+// When parser ← parse_obj_file(file)
+// And g ← parser.default_group
+// And t1 ← first child of g
+// And t2 ← second child of g
+// Then t1.p1 = parser.vertices[1]
+// And t1.p2 = parser.vertices[2]
+// And t1.p3 = parser.vertices[3]
+// And t1.n1 = parser.normals[3]
+// And t1.n2 = parser.normals[1]
+// And t1.n3 = parser.normals[2]
+// And t2 = t1
+//
+// The second face here uses real texture indices, as unlike the book
+// they are resolved and must exist.
+func TestObjFacesWithNormals(t *testing.T) {
+	file := `v 0 1 0
+v -1 0 0
+v 1 0 0
+
+vt 0 0
+vt 0.5 1
+vt 1 0
+
+vn -1 0 0
+vn 1 0 0
+vn 0 1 0
+
+f 1//3 2//1 3//2
+f 1/1/3 2/2/1 3/3/2`
+
+	p, err := ParseObj(strings.NewReader(file))
+	assert.NoError(t, err)
+	assert.Len(t, p.TextureCoords, 3)
+
+	g := p.DefaultGroup
+	assert.Len(t, g.Children, 2)
+
+	t1 := g.Children[0].(*SmoothTriangle)
+	t2 := g.Children[1].(*SmoothTriangle)
+
+	for _, tri := range []*SmoothTriangle{t1, t2} {
+		assert.Same(t, p.Vertices[0], tri.P1)
+		assert.Same(t, p.Vertices[1], tri.P2)
+		assert.Same(t, p.Vertices[2], tri.P3)
+		assert.Same(t, p.Normals[2], tri.N1)
+		assert.Same(t, p.Normals[0], tri.N2)
+		assert.Same(t, p.Normals[1], tri.N3)
+	}
+}
+
+// Negative indices count back from the last vertex defined
+func TestObjRelativeIndices(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+f -3 -2 -1`
+
+	p, err := ParseObj(strings.NewReader(file))
+	assert.NoError(t, err)
+
+	t1 := p.DefaultGroup.Children[0].(*Triangle)
+	assert.Same(t, p.Vertices[0], t1.P1)
+	assert.Same(t, p.Vertices[2], t1.P3)
+}
+
+// Malformed data and references to vertices that don't exist are errors
+func TestObjErrors(t *testing.T) {
+	_, err := ParseObj(strings.NewReader("v 1 2"))
+	assert.Error(t, err)
+
+	_, err = ParseObj(strings.NewReader("v 1 two 3"))
+	assert.Error(t, err)
+
+	_, err = ParseObj(strings.NewReader("v 1 2 3\nf 1 2 3"))
+	assert.EqualError(t, err, "obj line 2: index 2 out of range, only 1 defined")
+
+	_, err = ParseObj(strings.NewReader("v 1 2 3\nv 1 2 3\nf 1 2"))
+	assert.Error(t, err)
+}
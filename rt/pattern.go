@@ -0,0 +1,52 @@
+package rt
+
+// Pattern is implemented by everything that can color a surface in place
+// of a material's flat color. Patterns only need to know the color at a
+// point in their own pattern space, converting to it from world space is
+// handled by PatternAtShape.
+type Pattern interface {
+	Transform() *Transform
+	SetTransform(t *Transform)
+	Inverse() *Transform
+	LocalPatternAt(p *Point) *Color
+}
+
+// PatternAtShape converts a world space point into the shape's object space
+// and then into the pattern's space, so the pattern moves with the shape
+func PatternAtShape(pat Pattern, s Shape, p *Point) *Color {
+	op := WorldToObject(s, p)
+	return PatternAt(pat, op)
+}
+
+// PatternAt converts an object space point into pattern space and finds
+// the color there
+func PatternAt(pat Pattern, p *Point) *Color {
+	return pat.LocalPatternAt(pat.Inverse().TMulti(p))
+}
+
+// BasePattern holds the transform common to every pattern, caching its
+// inverse the same way BaseShape does. Always call SetTransform after
+// changing the transform.
+type BasePattern struct {
+	transform *Transform
+	inverse   *Transform
+}
+
+func NewBasePattern() BasePattern {
+	b := BasePattern{}
+	b.SetTransform(NewTransform())
+	return b
+}
+
+func (b *BasePattern) Transform() *Transform {
+	return b.transform
+}
+
+func (b *BasePattern) SetTransform(t *Transform) {
+	b.transform = t
+	b.inverse = t.Invert().(*Transform)
+}
+
+func (b *BasePattern) Inverse() *Transform {
+	return b.inverse
+}
@@ -0,0 +1,111 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testPattern colors each point with its own pattern space coordinates,
+// so tests can see exactly which point a pattern was asked for
+type testPattern struct {
+	BasePattern
+}
+
+func newTestPattern() *testPattern {
+	return &testPattern{
+		BasePattern: NewBasePattern(),
+	}
+}
+
+func (t *testPattern) LocalPatternAt(p *Point) *Color {
+	return NewColor(p.X, p.Y, p.Z, 1)
+}
+
+// Scenario: The default pattern transformation
+// Given pattern ← test_pattern()
+// Then pattern.transform = identity_matrix
+func TestPatternDefaultTransform(t *testing.T) {
+	p := newTestPattern()
+
+	assert.True(t, p.Transform().Equal(m4i))
+	assert.True(t, p.Inverse().Equal(m4i))
+}
+
+// Scenario: Assigning a transformation
+// Given pattern ← test_pattern()
+// When set_pattern_transform(pattern, translation(1, 2, 3))
+// Then pattern.transform = translation(1, 2, 3)
+func TestPatternSetTransform(t *testing.T) {
+	p := newTestPattern()
+	m := NewTransform().Translate(1, 2, 3)
+
+	p.SetTransform(m)
+
+	assert.Same(t, m, p.Transform())
+	assert.True(t, p.Inverse().Equal(m.Invert()))
+}
+
+// Scenario: A pattern with an object transformation
+// Given shape ← sphere()
+// And set_transform(shape, scaling(2, 2, 2))
+// And pattern ← test_pattern()
+// When c ← pattern_at_shape(pattern, shape, point(2, 3, 4))
+// Then c = color(1, 1.5, 2)
+func TestPatternObjectTransform(t *testing.T) {
+	s := NewSphere()
+	s.SetTransform(NewTransform().Scale(2, 2, 2))
+	p := newTestPattern()
+
+	c := PatternAtShape(p, s, NewPoint(2, 3, 4))
+
+	assert.True(t, NewColor(1, 1.5, 2, 1).Equals(c))
+}
+
+// Scenario: A pattern with a pattern transformation
+// Given shape ← sphere()
+// And pattern ← test_pattern()
+// And set_pattern_transform(pattern, scaling(2, 2, 2))
+// When c ← pattern_at_shape(pattern, shape, point(2, 3, 4))
+// Then c = color(1, 1.5, 2)
+func TestPatternPatternTransform(t *testing.T) {
+	s := NewSphere()
+	p := newTestPattern()
+	p.SetTransform(NewTransform().Scale(2, 2, 2))
+
+	c := PatternAtShape(p, s, NewPoint(2, 3, 4))
+
+	assert.True(t, NewColor(1, 1.5, 2, 1).Equals(c))
+}
+
+// Scenario: A pattern with both an object and a pattern transformation
+// Given shape ← sphere()
+// And set_transform(shape, scaling(2, 2, 2))
+// And pattern ← test_pattern()
+// And set_pattern_transform(pattern, translation(0.5, 1, 1.5))
+// When c ← pattern_at_shape(pattern, shape, point(2.5, 3, 3.5))
+// Then c = color(0.75, 0.5, 0.25)
+func TestPatternObjectAndPatternTransform(t *testing.T) {
+	s := NewSphere()
+	s.SetTransform(NewTransform().Scale(2, 2, 2))
+	p := newTestPattern()
+	p.SetTransform(NewTransform().Translate(0.5, 1, 1.5))
+
+	c := PatternAtShape(p, s, NewPoint(2.5, 3, 3.5))
+
+	assert.True(t, NewColor(0.75, 0.5, 0.25, 1).Equals(c))
+}
+
+// Patterns on a shape inside a group follow the group's transform too
+func TestPatternGroupTransform(t *testing.T) {
+	g := NewGroup()
+	g.SetTransform(NewTransform().Scale(2, 2, 2))
+	s := NewSphere()
+	s.SetTransform(NewTransform().Translate(1, 0, 0))
+	g.AddChild(s)
+	p := newTestPattern()
+
+	c := PatternAtShape(p, s, NewPoint(4, 2, 2))
+
+	assert.True(t, NewColor(1, 1, 1, 1).Equals(c))
+}
@@ -0,0 +1,33 @@
+package rt
+
+// DEFAULT_PERTURB_SCALE jitters points enough to look worn without the
+// underlying pattern becoming unrecognisable
+const DEFAULT_PERTURB_SCALE = 0.2
+
+// PerturbedPattern jitters the point with Perlin noise before handing it
+// to another pattern, breaking up its straight edges for marble and wood
+// like surfaces. Scale is how far, at most, a point is moved along each
+// axis.
+type PerturbedPattern struct {
+	BasePattern
+	Pattern Pattern
+	Scale   float64
+}
+
+func NewPerturbedPattern(p Pattern, scale float64) *PerturbedPattern {
+	return &PerturbedPattern{
+		BasePattern: NewBasePattern(),
+		Pattern:     p,
+		Scale:       scale,
+	}
+}
+
+func (pp *PerturbedPattern) LocalPatternAt(p *Point) *Color {
+	// Offset the noise samples for y and z so each axis moves differently
+	jp := NewPoint(
+		p.X+Noise(p.X, p.Y, p.Z)*pp.Scale,
+		p.Y+Noise(p.X, p.Y, p.Z+1)*pp.Scale,
+		p.Z+Noise(p.X, p.Y, p.Z+2)*pp.Scale,
+	)
+	return PatternAt(pp.Pattern, jp)
+}
@@ -0,0 +1,33 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// With no jitter a perturbed pattern is the same as the one it wraps
+func TestPerturbedPatternNoScale(t *testing.T) {
+	inner := newTestPattern()
+	p := NewPerturbedPattern(inner, 0)
+
+	pt := NewPoint(0.3, 1.7, -2.2)
+
+	assert.True(t, inner.LocalPatternAt(pt).Equals(p.LocalPatternAt(pt)))
+}
+
+// Points are moved by noise scaled by Scale, staying put where the noise
+// is 0 and never moving further than Scale along any axis
+func TestPerturbedPatternJitter(t *testing.T) {
+	p := NewPerturbedPattern(newTestPattern(), DEFAULT_PERTURB_SCALE)
+
+	assert.True(t, NewColor(1, 2, 2, 1).Equals(p.LocalPatternAt(NewPoint(1, 2, 2))))
+
+	pt := NewPoint(0.3, 1.7, -2.2)
+	c := p.LocalPatternAt(pt)
+
+	assert.False(t, NewColor(pt.X, pt.Y, pt.Z, 1).Equals(c))
+	assert.InDelta(t, pt.X, c.X, DEFAULT_PERTURB_SCALE)
+	assert.InDelta(t, pt.Y, c.Y, DEFAULT_PERTURB_SCALE)
+	assert.InDelta(t, pt.Z, c.Z, DEFAULT_PERTURB_SCALE)
+}
@@ -0,0 +1,30 @@
+package rt
+
+import "math"
+
+// Plane is an infinite plane in xz, facing up the y axis in object space
+type Plane struct {
+	BaseShape
+}
+
+func NewPlane() *Plane {
+	return &Plane{
+		BaseShape: NewBaseShape(),
+	}
+}
+
+func (p *Plane) LocalIntersect(r *Ray) Intersections {
+
+	// A ray parallel to the plane, or within it, never hits
+	if math.Abs(r.Direction.Y) < SMALL_NUMBER_F64 {
+		return Intersections{}
+	}
+
+	t := -r.Origin.Y / r.Direction.Y
+
+	return NewIntersections(NewIntersection(t, p))
+}
+
+func (p *Plane) LocalNormalAt(op *Point, hit *Intersection) *Vector {
+	return NewVector(0, 1, 0)
+}
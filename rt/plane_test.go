@@ -0,0 +1,74 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: The normal of a plane is constant everywhere
+// Given p ← plane()
+// When n1 ← local_normal_at(p, point(0, 0, 0))
+// And n2 ← local_normal_at(p, point(10, 0, -10))
+// And n3 ← local_normal_at(p, point(-5, 0, 150))
+// Then n1 = vector(0, 1, 0)
+// And n2 = vector(0, 1, 0)
+// And n3 = vector(0, 1, 0)
+func TestPlaneNormal(t *testing.T) {
+	p := NewPlane()
+
+	assert.True(t, NewVector(0, 1, 0).Equals(p.LocalNormalAt(NewPoint(0, 0, 0), nil)))
+	assert.True(t, NewVector(0, 1, 0).Equals(p.LocalNormalAt(NewPoint(10, 0, -10), nil)))
+	assert.True(t, NewVector(0, 1, 0).Equals(p.LocalNormalAt(NewPoint(-5, 0, 150), nil)))
+}
+
+// Scenario: Intersect with a ray parallel to the plane
+// Given p ← plane()
+// And r ← ray(point(0, 10, 0), vector(0, 0, 1))
+// When xs ← local_intersect(p, r)
+// Then xs is empty
+
+// Scenario: Intersect with a coplanar ray
+// Given p ← plane()
+// And r ← ray(point(0, 0, 0), vector(0, 0, 1))
+// When xs ← local_intersect(p, r)
+// Then xs is empty
+func TestPlaneIntersectParallel(t *testing.T) {
+	p := NewPlane()
+
+	xs1 := p.LocalIntersect(NewRay(NewPoint(0, 10, 0), NewVector(0, 0, 1)))
+	xs2 := p.LocalIntersect(NewRay(NewPoint(0, 0, 0), NewVector(0, 0, 1)))
+
+	assert.Len(t, xs1, 0)
+	assert.Len(t, xs2, 0)
+}
+
+// Scenario: A ray intersecting a plane from above
+// Given p ← plane()
+// And r ← ray(point(0, 1, 0), vector(0, -1, 0))
+// When xs ← local_intersect(p, r)
+// Then xs.count = 1
+// And xs[0].t = 1
+// And xs[0].object = p
+
+// Scenario: A ray intersecting a plane from below
+// Given p ← plane()
+// And r ← ray(point(0, -1, 0), vector(0, 1, 0))
+// When xs ← local_intersect(p, r)
+// Then xs.count = 1
+// And xs[0].t = 1
+// And xs[0].object = p
+func TestPlaneIntersect(t *testing.T) {
+	p := NewPlane()
+
+	xs1 := p.LocalIntersect(NewRay(NewPoint(0, 1, 0), NewVector(0, -1, 0)))
+	xs2 := p.LocalIntersect(NewRay(NewPoint(0, -1, 0), NewVector(0, 1, 0)))
+
+	assert.Len(t, xs1, 1)
+	assert.Equal(t, 1.0, xs1[0].T)
+	assert.Same(t, p, xs1[0].Object)
+
+	assert.Len(t, xs2, 1)
+	assert.Equal(t, 1.0, xs2[0].T)
+	assert.Same(t, p, xs2[0].Object)
+}
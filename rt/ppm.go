@@ -0,0 +1,271 @@
+package rt
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// PPMFormat is which flavour of PPM WritePPM produces
+type PPMFormat int
+
+const (
+	// PPM_P6 stores pixels as raw bytes, it is the default as it is far
+	// smaller and faster to write
+	PPM_P6 PPMFormat = iota
+	// PPM_P3 stores pixels as text, wrapped to PPM_MAX_CHARS per line
+	PPM_P3
+)
+
+// PPM_MAX_VALUE is the largest channel value a PPM may declare, anything
+// over 255 is stored as two bytes per channel in P6
+const PPM_MAX_VALUE = 65535
+
+// PPM_MAX_PIXELS caps the size a PPM header may declare, so a bad one can't
+// ask for an impossible amount of memory. The default fits 8K textures like
+// 8192x4096 globes and 8192x8192 skybox faces, raise it to load larger ones.
+var PPM_MAX_PIXELS = 8192 * 8192
+
+// ErrPPMHeader is wrapped by every error about a malformed PPM header
+var ErrPPMHeader = errors.New("invalid ppm header")
+
+// CanvasFromPPM reads a P3 or P6 image into a canvas, scaling each
+// channel from 0 to the file's max value into 0 to 1. Comments and any
+// amount of whitespace are allowed between header values, and between
+// P3 pixel values.
+func CanvasFromPPM(r io.Reader) (*Canvas, error) {
+	pr := ppmReader{br: bufio.NewReader(r)}
+
+	magic, err := pr.token()
+	if err != nil {
+		return nil, fmt.Errorf("%w: missing magic number: %v", ErrPPMHeader, err)
+	}
+	if magic != "P3" && magic != "P6" {
+		return nil, fmt.Errorf("%w: unsupported format %q, expected P3 or P6", ErrPPMHeader, magic)
+	}
+
+	var dims [3]int
+	for i, name := range []string{"width", "height", "max value"} {
+		if dims[i], err = pr.int(); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrPPMHeader, name, err)
+		}
+	}
+
+	width, height, maxval := dims[0], dims[1], dims[2]
+
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("%w: size must be positive, got %dx%d", ErrPPMHeader, width, height)
+	}
+	// Divided rather than multiplied so huge sizes can't overflow
+	if width > PPM_MAX_PIXELS/height {
+		return nil, fmt.Errorf("%w: size %dx%d is over %d pixels", ErrPPMHeader, width, height, PPM_MAX_PIXELS)
+	}
+	if maxval <= 0 || maxval > PPM_MAX_VALUE {
+		return nil, fmt.Errorf("%w: max value must be 1 to %d, got %d", ErrPPMHeader, PPM_MAX_VALUE, maxval)
+	}
+
+	c := NewCanvas(width, height)
+
+	if magic == "P6" {
+		err = pr.readP6(c, maxval)
+	} else {
+		err = pr.readP3(c, maxval)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ppmReader splits a PPM into whitespace separated tokens, skipping
+// comments which run from a # to the end of the line
+type ppmReader struct {
+	br *bufio.Reader
+}
+
+func (pr *ppmReader) token() (string, error) {
+	var tok []byte
+
+	for {
+		b, err := pr.br.ReadByte()
+		if err == io.EOF && len(tok) > 0 {
+			return string(tok), nil
+		}
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return "", err
+		}
+
+		switch {
+		case b == '#':
+			if _, err := pr.br.ReadString('\n'); err != nil && err != io.EOF {
+				return "", err
+			}
+			if len(tok) > 0 {
+				return string(tok), nil
+			}
+		case isPPMSpace(b):
+			if len(tok) > 0 {
+				return string(tok), nil
+			}
+		default:
+			tok = append(tok, b)
+		}
+	}
+}
+
+func (pr *ppmReader) int() (int, error) {
+	tok, err := pr.token()
+	if err != nil {
+		return 0, err
+	}
+
+	i, err := strconv.Atoi(tok)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", tok)
+	}
+	return i, nil
+}
+
+func (pr *ppmReader) readP3(c *Canvas, maxval int) error {
+	scale := float64(maxval)
+
+	for i := range c.Data {
+		var ch [3]float64
+		for j := range ch {
+			v, err := pr.int()
+			if err != nil {
+				return fmt.Errorf("ppm data: pixel %d: %w", i, err)
+			}
+			if v < 0 || v > maxval {
+				return fmt.Errorf("ppm data: pixel %d: value %d is outside 0 to %d", i, v, maxval)
+			}
+			ch[j] = float64(v) / scale
+		}
+		c.Data[i] = NewColor(ch[0], ch[1], ch[2], 1)
+	}
+
+	return nil
+}
+
+func (pr *ppmReader) readP6(c *Canvas, maxval int) error {
+	// The token reader has already used up the single whitespace that
+	// separates the header from the binary pixels
+	size := 1
+	if maxval > 255 {
+		size = 2
+	}
+
+	scale := float64(maxval)
+	px := make([]byte, 3*size)
+
+	for i := range c.Data {
+		if _, err := io.ReadFull(pr.br, px); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return fmt.Errorf("ppm data: pixel %d: %w", i, err)
+		}
+
+		var ch [3]float64
+		for j := range ch {
+			v := int(px[j*size])
+			if size == 2 {
+				v = v<<8 | int(px[j*size+1])
+			}
+			if v > maxval {
+				return fmt.Errorf("ppm data: pixel %d: value %d is outside 0 to %d", i, v, maxval)
+			}
+			ch[j] = float64(v) / scale
+		}
+		c.Data[i] = NewColor(ch[0], ch[1], ch[2], 1)
+	}
+
+	return nil
+}
+
+func isPPMSpace(b byte) bool {
+	switch b {
+	case ' ', '\t', '\n', '\r', '\v', '\f':
+		return true
+	}
+	return false
+}
+
+// WritePPM streams the canvas to w as a PPM with a max value of 255.
+// Pixels that were never set are written as black.
+func (ca *Canvas) WritePPM(w io.Writer, format PPMFormat) error {
+	bw := bufio.NewWriter(w)
+
+	magic := "P6"
+	if format == PPM_P3 {
+		magic = "P3"
+	}
+	fmt.Fprintf(bw, "%s\n%d %d\n255\n", magic, ca.Width, ca.Height)
+
+	if format == PPM_P3 {
+		ca.writeP3(bw)
+	} else {
+		ca.writeP6(bw)
+	}
+
+	return bw.Flush()
+}
+
+// writeP6 writes each channel as a single byte. Write errors are held by
+// the bufio.Writer and returned from Flush.
+func (ca *Canvas) writeP6(bw *bufio.Writer) {
+	px := make([]byte, 3)
+
+	for _, d := range ca.Data {
+		if d == nil {
+			px[0], px[1], px[2] = 0, 0, 0
+		} else {
+			px[0] = byte(F64ToInt_RGB255(d.X))
+			px[1] = byte(F64ToInt_RGB255(d.Y))
+			px[2] = byte(F64ToInt_RGB255(d.Z))
+		}
+		bw.Write(px)
+	}
+}
+
+// writeP3 writes each row of pixels as text, starting a new line whenever
+// the next value would take it over PPM_MAX_CHARS
+func (ca *Canvas) writeP3(bw *bufio.Writer) {
+	for y := 0; y < ca.Height; y++ {
+		lw := 0
+
+		for x := 0; x < ca.Width; x++ {
+			d := ca.Data[x+y*ca.Width]
+
+			var ch [3]float64
+			if d != nil {
+				ch = [3]float64{d.X, d.Y, d.Z}
+			}
+
+			for _, f := range ch {
+				s := F64ToStr_RGB255(f)
+
+				switch {
+				case lw == 0:
+				case lw+1+len(s) >= PPM_MAX_CHARS:
+					bw.WriteByte('\n')
+					lw = 0
+				default:
+					bw.WriteByte(' ')
+					lw++
+				}
+
+				bw.WriteString(s)
+				lw += len(s)
+			}
+		}
+
+		bw.WriteByte('\n')
+	}
+}
@@ -0,0 +1,364 @@
+package rt
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: Reading a file with the wrong magic number
+// Given ppm ← a file containing:
+// """
+// P32
+// 1 1
+// 255
+// 0 0 0
+// """
+// Then canvas_from_ppm(ppm) should fail
+func TestPPMReadWrongMagic(t *testing.T) {
+	_, err := CanvasFromPPM(strings.NewReader("P32\n1 1\n255\n0 0 0\n"))
+
+	assert.Error(t, err)
+}
+
+// Scenario: Reading a PPM returns a canvas of the right size
+// Given ppm ← a file containing:
+// """
+// P3
+// 10 2
+// 255
+// 0 0 0  0 0 0  0 0 0  0 0 0  0 0 0  0 0 0  0 0 0  0 0 0  0 0 0  0 0 0
+// 0 0 0  0 0 0  0 0 0  0 0 0  0 0 0  0 0 0  0 0 0  0 0 0  0 0 0  0 0 0
+// """
+// When canvas ← canvas_from_ppm(ppm)
+// Then canvas.width = 10
+// And canvas.height = 2
+func TestPPMReadSize(t *testing.T) {
+	ppm := "P3\n10 2\n255\n" + strings.Repeat("0 0 0  ", 20) + "\n"
+
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, c.Width)
+	assert.Equal(t, 2, c.Height)
+}
+
+// Scenario Outline: Reading pixel data from a PPM file
+// Given ppm ← a file containing:
+// """
+// P3
+// 4 3
+// 255
+// 255 127 0  0 127 255  127 255 0  255 255 255
+// 0 0 0  255 0 0  0 255 0  0 0 255
+// 255 255 0  0 255 255  255 0 255  127 127 127
+// """
+// When canvas ← canvas_from_ppm(ppm)
+// Then pixel_at(canvas, <x>, <y>) = <color>
+func TestPPMReadP3(t *testing.T) {
+	ppm := `P3
+4 3
+255
+255 127 0  0 127 255  127 255 0  255 255 255
+0 0 0  255 0 0  0 255 0  0 0 255
+255 255 0  0 255 255  255 0 255  127 127 127
+`
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		x, y    int
+		r, g, b float64
+	}{
+		{0, 0, 1, 0.49804, 0},
+		{1, 0, 0, 0.49804, 1},
+		{2, 0, 0.49804, 1, 0},
+		{3, 0, 1, 1, 1},
+		{0, 1, 0, 0, 0},
+		{1, 1, 1, 0, 0},
+		{2, 1, 0, 1, 0},
+		{3, 1, 0, 0, 1},
+		{0, 2, 1, 1, 0},
+		{1, 2, 0, 1, 1},
+		{2, 2, 1, 0, 1},
+		{3, 2, 0.49804, 0.49804, 0.49804},
+	}
+
+	for _, tt := range tests {
+		p := c.Get(tt.x, tt.y)
+		assert.InDelta(t, tt.r, p.X, 0.0001, "%d %d", tt.x, tt.y)
+		assert.InDelta(t, tt.g, p.Y, 0.0001, "%d %d", tt.x, tt.y)
+		assert.InDelta(t, tt.b, p.Z, 0.0001, "%d %d", tt.x, tt.y)
+	}
+}
+
+// Binary P6 pixels follow a single whitespace after the header
+func TestPPMReadP6(t *testing.T) {
+	ppm := "P6\n2 1\n255\n" + string([]byte{255, 0, 51, 0, 255, 102})
+
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	assert.NoError(t, err)
+
+	assert.True(t, NewColor(1, 0, 0.2, 1).Equals(c.Get(0, 0)))
+	assert.True(t, NewColor(0, 1, 0.4, 1).Equals(c.Get(1, 0)))
+}
+
+// A file that ends before all the pixels are read is an error
+func TestPPMReadTruncated(t *testing.T) {
+	_, err := CanvasFromPPM(strings.NewReader("P3\n2 1\n255\n0 0 0\n"))
+	assert.Error(t, err)
+
+	_, err = CanvasFromPPM(strings.NewReader("P6\n2 1\n255\n\x00\x00\x00"))
+	assert.Error(t, err)
+}
+
+// Scenario: PPM parsing ignores comment lines
+// Given ppm ← a file containing:
+// """
+// P3
+// # this is a comment
+// 2 1
+// # this, too
+// 255
+// # another comment
+// 255 255 255
+// # oh, no, comments in the pixel data!
+// 255 0 255
+// """
+// When canvas ← canvas_from_ppm(ppm)
+// Then pixel_at(canvas, 0, 0) = color(1, 1, 1)
+// And pixel_at(canvas, 1, 0) = color(1, 0, 1)
+func TestPPMReadComments(t *testing.T) {
+	ppm := `P3
+# this is a comment
+2 1
+# this, too
+255
+# another comment
+255 255 255
+# oh, no, comments in the pixel data!
+255 0 255
+`
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	assert.NoError(t, err)
+
+	assert.True(t, NewColor(1, 1, 1, 1).Equals(c.Get(0, 0)))
+	assert.True(t, NewColor(1, 0, 1, 1).Equals(c.Get(1, 0)))
+}
+
+// Scenario: PPM parsing allows an RGB triple to span lines
+// Given ppm ← a file containing:
+// """
+// P3
+// 1 1
+// 255
+// 51
+// 153
+//
+// 204
+// """
+// When canvas ← canvas_from_ppm(ppm)
+// Then pixel_at(canvas, 0, 0) = color(0.2, 0.6, 0.8)
+func TestPPMReadSpanLines(t *testing.T) {
+	ppm := "P3\n1 1\n255\n51\n153\n\n204\n"
+
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	assert.NoError(t, err)
+
+	assert.True(t, NewColor(0.2, 0.6, 0.8, 1).Equals(c.Get(0, 0)))
+}
+
+// Scenario: PPM parsing respects the scale setting
+// Given ppm ← a file containing:
+// """
+// P3
+// 2 2
+// 100
+// 100 100 100  50 50 50
+// 75 50 25  0 0 0
+// """
+// When canvas ← canvas_from_ppm(ppm)
+// Then pixel_at(canvas, 0, 1) = color(0.75, 0.5, 0.25)
+func TestPPMReadScale(t *testing.T) {
+	ppm := "P3\n2 2\n100\n100 100 100  50 50 50\n75 50 25  0 0 0\n"
+
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	assert.NoError(t, err)
+
+	assert.True(t, NewColor(0.75, 0.5, 0.25, 1).Equals(c.Get(0, 1)))
+}
+
+// Header values can be separated by any whitespace, with comments
+// running right up against them
+func TestPPMReadWhitespace(t *testing.T) {
+	ppm := "P3\t2\r\n  1 # size\n\f255#max\n0 0 0\t\t255 255 255"
+
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	assert.NoError(t, err)
+
+	assert.True(t, NewColor(0, 0, 0, 1).Equals(c.Get(0, 0)))
+	assert.True(t, NewColor(1, 1, 1, 1).Equals(c.Get(1, 0)))
+}
+
+// P6 files with a max value over 255 use two big endian bytes per channel
+func TestPPMReadP6Wide(t *testing.T) {
+	ppm := "P6\n# wide\n1 1\n1000\n" + string([]byte{0x03, 0xe8, 0x01, 0xf4, 0x00, 0x00})
+
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	assert.NoError(t, err)
+
+	assert.True(t, NewColor(1, 0.5, 0, 1).Equals(c.Get(0, 0)))
+}
+
+// A P6 max value under 255 still scales its single bytes
+func TestPPMReadP6Scale(t *testing.T) {
+	ppm := "P6 1 1 100\n" + string([]byte{100, 50, 25})
+
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	assert.NoError(t, err)
+
+	assert.True(t, NewColor(1, 0.5, 0.25, 1).Equals(c.Get(0, 0)))
+}
+
+// Malformed headers are reported as ErrPPMHeader
+func TestPPMReadBadHeader(t *testing.T) {
+	tests := []string{
+		"",
+		"P3",
+		"P3\n2\n",
+		"P3\n2 1\n",
+		"P3\nwide 1\n255\n",
+		"P3\n0 1\n255\n",
+		"P3\n2 -1\n255\n",
+		"P3\n1 1\n0\n",
+		"P3\n1 1\n65536\n",
+		"P5\n1 1\n255\n0\n",
+		"P3 100000000 100000000 255\n",
+		"P3 9223372036854775807 2 255\n",
+	}
+
+	for _, ppm := range tests {
+		_, err := CanvasFromPPM(strings.NewReader(ppm))
+		assert.True(t, errors.Is(err, ErrPPMHeader), "%q gave %v", ppm, err)
+	}
+}
+
+// Pixel values must be numbers no larger than the max value
+func TestPPMReadBadData(t *testing.T) {
+	_, err := CanvasFromPPM(strings.NewReader("P3\n1 1\n100\n101 0 0\n"))
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrPPMHeader))
+
+	_, err = CanvasFromPPM(strings.NewReader("P3\n1 1\n255\n0 red 0\n"))
+	assert.Error(t, err)
+
+	_, err = CanvasFromPPM(strings.NewReader("P6\n1 1\n1000\n" + string([]byte{0xff, 0xff, 0, 0, 0, 0})))
+	assert.Error(t, err)
+}
+
+// 8K textures fit under the default size limit, which can be changed
+func TestPPMReadMaxPixels(t *testing.T) {
+	// Truncated data, so only the header has to be accepted
+	_, err := CanvasFromPPM(strings.NewReader("P6\n8192 4096\n255\n"))
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrPPMHeader), "%v", err)
+
+	_, err = CanvasFromPPM(strings.NewReader("P6\n8192 8193\n255\n"))
+	assert.True(t, errors.Is(err, ErrPPMHeader))
+
+	defer func(max int) { PPM_MAX_PIXELS = max }(PPM_MAX_PIXELS)
+	PPM_MAX_PIXELS = 4
+
+	_, err = CanvasFromPPM(strings.NewReader("P3\n2 2\n255\n" + strings.Repeat("0 0 0\n", 4)))
+	assert.NoError(t, err)
+
+	_, err = CanvasFromPPM(strings.NewReader("P3\n3 2\n255\n" + strings.Repeat("0 0 0\n", 6)))
+	assert.True(t, errors.Is(err, ErrPPMHeader))
+}
+
+// Scenario: PPM files are terminated by a newline character
+// Given c ← canvas(5, 3)
+// When ppm ← canvas_to_ppm(c)
+// Then ppm ends with a newline character
+func TestPPMWriteP3Newline(t *testing.T) {
+	var buf bytes.Buffer
+
+	assert.NoError(t, NewCanvas(5, 3).WritePPM(&buf, PPM_P3))
+
+	assert.True(t, strings.HasSuffix(buf.String(), "\n"))
+	assert.Equal(t, NewCanvas(5, 3).ToPPM(), buf.String())
+}
+
+// P6 is the default, a text header followed by a byte per channel with
+// unset pixels written as black
+func TestPPMWriteP6(t *testing.T) {
+	c := NewCanvas(2, 2)
+	c.Set(0, 0, NewColor(1.5, 0, 0, 1))
+	c.Set(1, 0, NewColor(0, 0.5, 0, 1))
+	c.Set(1, 1, NewColor(-0.5, 0, 1, 1))
+
+	var buf bytes.Buffer
+	var format PPMFormat
+	assert.NoError(t, c.WritePPM(&buf, format))
+
+	expected := "P6\n2 2\n255\n" + string([]byte{
+		255, 0, 0, 0, 128, 0,
+		0, 0, 0, 0, 0, 255,
+	})
+	assert.Equal(t, expected, buf.String())
+}
+
+// Both formats read back in as the same canvas
+func TestPPMWriteRoundTrip(t *testing.T) {
+	c := NewCanvas(3, 2)
+	for y := 0; y < c.Height; y++ {
+		for x := 0; x < c.Width; x++ {
+			c.Set(x, y, NewColor(float64(x)/2, float64(y), 0.2, 1))
+		}
+	}
+
+	for _, format := range []PPMFormat{PPM_P3, PPM_P6} {
+		var buf bytes.Buffer
+		assert.NoError(t, c.WritePPM(&buf, format))
+
+		r, err := CanvasFromPPM(&buf)
+		assert.NoError(t, err)
+
+		for i, d := range c.Data {
+			assert.InDelta(t, d.X, r.Data[i].X, 1.0/255, "format %d pixel %d", format, i)
+			assert.InDelta(t, d.Y, r.Data[i].Y, 1.0/255, "format %d pixel %d", format, i)
+			assert.InDelta(t, d.Z, r.Data[i].Z, 1.0/255, "format %d pixel %d", format, i)
+		}
+	}
+}
+
+// No P3 line is longer than PPM_MAX_CHARS, however wide the canvas
+func TestPPMWriteP3LineWidth(t *testing.T) {
+	c := NewCanvas(100, 3)
+	for i := range c.Data {
+		c.Data[i] = NewColor(1, 0.8, 0.6, 1)
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, c.WritePPM(&buf, PPM_P3))
+
+	for _, l := range strings.Split(buf.String(), "\n") {
+		assert.Less(t, len(l), PPM_MAX_CHARS)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+// Errors from the underlying writer are returned
+func TestPPMWriteError(t *testing.T) {
+	err := NewCanvas(10, 10).WritePPM(failingWriter{}, PPM_P6)
+
+	assert.EqualError(t, err, "disk full")
+}
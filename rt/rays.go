@@ -11,3 +11,13 @@ func NewRay(origin *Point, Direction *Vector) *Ray {
 		Direction: Direction,
 	}
 }
+
+// Position returns the point at distance t along the ray
+func (r *Ray) Position(t float64) *Point {
+	return r.Origin.Add(r.Direction.Multi(t))
+}
+
+// Transform returns a new ray with m applied to both the origin and direction
+func (r *Ray) Transform(m *Transform) *Ray {
+	return NewRay(m.TMulti(r.Origin), m.TMulti(r.Direction))
+}
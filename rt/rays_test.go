@@ -21,3 +21,50 @@ func TestRayNew(t *testing.T) {
 	assert.True(t, v1.Equals(r1.Direction))
 
 }
+
+// Scenario: Computing a point from a distance
+// Given r ← ray(point(2, 3, 4), vector(1, 0, 0))
+// Then position(r, 0) = point(2, 3, 4)
+// And position(r, 1) = point(3, 3, 4)
+// And position(r, -1) = point(1, 3, 4)
+// And position(r, 2.5) = point(4.5, 3, 4)
+func TestRayPosition(t *testing.T) {
+	r := NewRay(NewPoint(2, 3, 4), NewVector(1, 0, 0))
+
+	assert.True(t, NewPoint(2, 3, 4).Equals(r.Position(0)))
+	assert.True(t, NewPoint(3, 3, 4).Equals(r.Position(1)))
+	assert.True(t, NewPoint(1, 3, 4).Equals(r.Position(-1)))
+	assert.True(t, NewPoint(4.5, 3, 4).Equals(r.Position(2.5)))
+}
+
+// Scenario: Translating a ray
+// Given r ← ray(point(1, 2, 3), vector(0, 1, 0))
+// And m ← translation(3, 4, 5)
+// When r2 ← transform(r, m)
+// Then r2.origin = point(4, 6, 8)
+// And r2.direction = vector(0, 1, 0)
+func TestRayTranslate(t *testing.T) {
+	r := NewRay(NewPoint(1, 2, 3), NewVector(0, 1, 0))
+	m := NewTransform().Translate(3, 4, 5)
+
+	r2 := r.Transform(m)
+
+	assert.True(t, NewPoint(4, 6, 8).Equals(r2.Origin))
+	assert.True(t, NewVector(0, 1, 0).Equals(r2.Direction))
+}
+
+// Scenario: Scaling a ray
+// Given r ← ray(point(1, 2, 3), vector(0, 1, 0))
+// And m ← scaling(2, 3, 4)
+// When r2 ← transform(r, m)
+// Then r2.origin = point(2, 6, 12)
+// And r2.direction = vector(0, 3, 0)
+func TestRayScale(t *testing.T) {
+	r := NewRay(NewPoint(1, 2, 3), NewVector(0, 1, 0))
+	m := NewTransform().Scale(2, 3, 4)
+
+	r2 := r.Transform(m)
+
+	assert.True(t, NewPoint(2, 6, 12).Equals(r2.Origin))
+	assert.True(t, NewVector(0, 3, 0).Equals(r2.Direction))
+}
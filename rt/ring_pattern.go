@@ -0,0 +1,32 @@
+package rt
+
+import "math"
+
+// RingPattern alternates between A and B in concentric rings around the
+// y axis, one unit wide
+type RingPattern struct {
+	BasePattern
+	A Pattern
+	B Pattern
+}
+
+func NewRingPattern(a, b *Color) *RingPattern {
+	return NewNestedRingPattern(NewSolidPattern(a), NewSolidPattern(b))
+}
+
+// NewNestedRingPattern creates rings that are filled with other patterns
+func NewNestedRingPattern(a, b Pattern) *RingPattern {
+	return &RingPattern{
+		BasePattern: NewBasePattern(),
+		A:           a,
+		B:           b,
+	}
+}
+
+func (r *RingPattern) LocalPatternAt(p *Point) *Color {
+	d := math.Sqrt(p.X*p.X + p.Z*p.Z)
+	if int(math.Floor(d))%2 == 0 {
+		return PatternAt(r.A, p)
+	}
+	return PatternAt(r.B, p)
+}
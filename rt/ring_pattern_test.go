@@ -0,0 +1,23 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: A ring should extend in both x and z
+// Given pattern ← ring_pattern(white, black)
+// Then pattern_at(pattern, point(0, 0, 0)) = white
+// And pattern_at(pattern, point(1, 0, 0)) = black
+// And pattern_at(pattern, point(0, 0, 1)) = black
+// # 0.708 = just slightly more than √2/2
+// And pattern_at(pattern, point(0.708, 0, 0.708)) = black
+func TestRingPattern(t *testing.T) {
+	p := NewRingPattern(patternWhite, patternBlack)
+
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0, 0))))
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(1, 0, 0))))
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(0, 0, 1))))
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(0.708, 0, 0.708))))
+}
@@ -0,0 +1,772 @@
+package rt
+
+import (
+	"errors"
+	"io"
+	"math"
+	"os"
+	"path"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// sceneLoader reads the YAML scene format from the ray tracer challenge.
+// The file is a list of `add` items that create the camera, lights and
+// shapes, and `define` items that name a material or transform list for
+// reuse, optionally extending an earlier define.
+//
+// Every problem with the scene is returned as a *SceneError pointing at
+// the offending node.
+type sceneLoader struct {
+	dir       string
+	defines   map[string]*yaml.Node
+	resolving map[string]bool
+	world     *World
+	camera    *Camera
+}
+
+var sceneShapeKeys = []string{"add", "material", "transform", "shadow"}
+
+// sceneShapeKindKeys are the keys each kind of shape allows on top of
+// sceneShapeKeys, it is also the list of known shapes
+var sceneShapeKindKeys = map[string][]string{
+	"sphere":   nil,
+	"plane":    nil,
+	"cube":     nil,
+	"cylinder": {"min", "max", "closed"},
+	"cone":     {"min", "max", "closed"},
+	"triangle": {"p1", "p2", "p3"},
+	"group":    {"children"},
+	"csg":      {"operation", "left", "right"},
+	"obj":      {"file"},
+}
+
+var sceneMaterialKeys = []string{
+	"color", "ambient", "diffuse", "specular", "shininess",
+	"reflective", "transparency", "refractive-index", "pattern",
+}
+
+var sceneTransformArity = map[string]int{
+	"translate": 3,
+	"scale":     3,
+	"rotate-x":  1,
+	"rotate-y":  1,
+	"rotate-z":  1,
+	"shear":     6,
+}
+
+// LoadScene reads a YAML scene, returning the world and the camera to render it with
+func LoadScene(r io.Reader) (*World, *Camera, error) {
+	return loadScene(r, "", "")
+}
+
+// LoadSceneFile reads a YAML scene from disk, any files it references are
+// relative to the scene
+func LoadSceneFile(filename string) (*World, *Camera, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	return loadScene(f, filename, path.Dir(filename))
+}
+
+func loadScene(r io.Reader, filename, dir string) (w *World, c *Camera, err error) {
+
+	defer func() {
+		var se *SceneError
+		if errors.As(err, &se) {
+			se.File = filename
+		}
+	}()
+
+	var doc yaml.Node
+
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, nil, syntaxError(err)
+	}
+
+	l := sceneLoader{
+		dir:       dir,
+		defines:   map[string]*yaml.Node{},
+		resolving: map[string]bool{},
+		world:     NewWorld(),
+	}
+
+	if err := l.load(&doc); err != nil {
+		return nil, nil, err
+	}
+
+	if l.camera == nil {
+		return nil, nil, nodeError(nil, ErrSceneNoCamera, "scene has no camera")
+	}
+
+	return l.world, l.camera, nil
+}
+
+func (l *sceneLoader) load(doc *yaml.Node) error {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nodeError(nil, ErrSceneSyntax, "scene is empty")
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.SequenceNode {
+		return nodeError(root, ErrSceneSyntax, "scene must be a list of items")
+	}
+
+	for _, item := range root.Content {
+		if item.Kind != yaml.MappingNode {
+			return nodeError(item, ErrSceneSyntax, "scene items must be mappings")
+		}
+
+		var err error
+
+		switch {
+		case mappingValue(item, "add") != nil:
+			err = l.add(item)
+		case mappingValue(item, "define") != nil:
+			err = l.define(item)
+		default:
+			return nodeError(item, ErrUnknownItem, "scene items must be an add or a define")
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mappingValue returns the value for key in a mapping node, or nil
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func (l *sceneLoader) define(item *yaml.Node) error {
+	if err := checkKeys(item, "define", "define", "extend", "value"); err != nil {
+		return err
+	}
+
+	nameNode := mappingValue(item, "define")
+	name := nameNode.Value
+
+	if nameNode.Kind != yaml.ScalarNode || name == "" {
+		return nodeError(nameNode, ErrInvalidValue, "define needs a name")
+	}
+	if _, ok := l.defines[name]; ok {
+		return nodeError(nameNode, ErrDuplicateDefine, "define %s is already defined", name)
+	}
+
+	value := mappingValue(item, "value")
+	if value == nil {
+		return nodeError(item, ErrMissingKey, "define %s has no value", name)
+	}
+
+	// An extended define is the parent's mapping with this one's keys on top
+	if ext := mappingValue(item, "extend"); ext != nil {
+		parent, ok := l.defines[ext.Value]
+		if !ok && ext.Value == name {
+			return nodeError(ext, ErrRecursiveDefine, "define %s extends itself", name)
+		}
+		if !ok {
+			return nodeError(ext, ErrUnknownDefine, "define %s extends unknown define %s", name, ext.Value)
+		}
+		if parent.Kind != yaml.MappingNode || value.Kind != yaml.MappingNode {
+			return nodeError(ext, ErrInvalidValue, "define %s can only extend a mapping with a mapping", name)
+		}
+		value = mergeMappings(parent, value)
+	}
+
+	l.defines[name] = value
+	return nil
+}
+
+func mergeMappings(base, over *yaml.Node) *yaml.Node {
+	m := &yaml.Node{Kind: yaml.MappingNode, Line: over.Line, Column: over.Column}
+
+	for i := 0; i+1 < len(base.Content); i += 2 {
+		if mappingValue(over, base.Content[i].Value) == nil {
+			m.Content = append(m.Content, base.Content[i], base.Content[i+1])
+		}
+	}
+	m.Content = append(m.Content, over.Content...)
+
+	return m
+}
+
+// lookup finds a define by name, marking it as in use until done is
+// called so a define that ends up referring to itself is caught
+func (l *sceneLoader) lookup(n *yaml.Node) (d *yaml.Node, done func(), err error) {
+	d, ok := l.defines[n.Value]
+	if !ok {
+		return nil, nil, nodeError(n, ErrUnknownDefine, "unknown define %s", n.Value)
+	}
+
+	if l.resolving[n.Value] {
+		return nil, nil, nodeError(n, ErrRecursiveDefine, "define %s refers to itself", n.Value)
+	}
+
+	l.resolving[n.Value] = true
+	return d, func() { delete(l.resolving, n.Value) }, nil
+}
+
+func (l *sceneLoader) add(item *yaml.Node) error {
+	switch kind := mappingValue(item, "add").Value; kind {
+	case "camera":
+		return l.addCamera(item)
+	case "light":
+		return l.addLight(item)
+	default:
+		s, err := l.shape(item, nil)
+		if err != nil {
+			return err
+		}
+		l.world.AddObject(s)
+		return nil
+	}
+}
+
+func (l *sceneLoader) addCamera(item *yaml.Node) error {
+	err := checkKeys(item, "camera", "add", "width", "height", "field-of-view", "from", "to", "up")
+	if err != nil {
+		return err
+	}
+
+	for _, k := range []string{"width", "height", "field-of-view", "from", "to", "up"} {
+		if mappingValue(item, k) == nil {
+			return nodeError(item, ErrMissingKey, "camera needs %s", k)
+		}
+	}
+
+	width, err := sceneInt(mappingValue(item, "width"))
+	if err != nil {
+		return err
+	}
+	height, err := sceneInt(mappingValue(item, "height"))
+	if err != nil {
+		return err
+	}
+	fov, err := sceneFloat(mappingValue(item, "field-of-view"))
+	if err != nil {
+		return err
+	}
+	from, err := scenePoint(mappingValue(item, "from"))
+	if err != nil {
+		return err
+	}
+	to, err := scenePoint(mappingValue(item, "to"))
+	if err != nil {
+		return err
+	}
+	up, err := sceneVector(mappingValue(item, "up"))
+	if err != nil {
+		return err
+	}
+
+	if width <= 0 || height <= 0 {
+		return nodeError(item, ErrInvalidValue, "camera width and height must be positive")
+	}
+
+	if fov <= 0 || fov >= math.Pi {
+		return nodeError(mappingValue(item, "field-of-view"), ErrInvalidValue,
+			"field-of-view must be between 0 and pi")
+	}
+
+	vt := ViewTransform(from, to, up)
+	if !isFinite(vt) || !vt.IsInvertable() {
+		return nodeError(item, ErrInvalidValue, "camera from, to and up don't give a valid view")
+	}
+
+	l.camera = NewCamera(width, height, fov)
+	l.camera.SetTransform(vt)
+
+	return nil
+}
+
+func (l *sceneLoader) addLight(item *yaml.Node) error {
+	if err := checkKeys(item, "light", "add", "at", "intensity"); err != nil {
+		return err
+	}
+
+	for _, k := range []string{"at", "intensity"} {
+		if mappingValue(item, k) == nil {
+			return nodeError(item, ErrMissingKey, "light needs %s", k)
+		}
+	}
+
+	at, err := scenePoint(mappingValue(item, "at"))
+	if err != nil {
+		return err
+	}
+	intensity, err := sceneColor(mappingValue(item, "intensity"))
+	if err != nil {
+		return err
+	}
+
+	l.world.AddLight(NewPointLight(at, intensity))
+
+	return nil
+}
+
+// shape builds the primitive for an add item, along with its common
+// material, transform and shadow keys. Shapes without a material of their
+// own use the inherited one from the group, csg or obj they are in, as
+// only the primitive that was hit is ever shaded.
+func (l *sceneLoader) shape(item *yaml.Node, inherited *Material) (Shape, error) {
+	kindNode := mappingValue(item, "add")
+	kind := kindNode.Value
+
+	keys, ok := sceneShapeKindKeys[kind]
+	if !ok {
+		return nil, nodeError(kindNode, ErrUnknownShape, "unknown shape %s", kind)
+	}
+	if err := checkKeys(item, kind, append(keys, sceneShapeKeys...)...); err != nil {
+		return nil, err
+	}
+
+	m := inherited
+	if v := mappingValue(item, "material"); v != nil {
+		var err error
+		if m, err = l.material(v); err != nil {
+			return nil, err
+		}
+	}
+
+	var s Shape
+	var err error
+
+	switch kind {
+	case "sphere":
+		s = NewSphere()
+	case "plane":
+		s = NewPlane()
+	case "cube":
+		s = NewCube()
+	case "cylinder":
+		c := NewCylinder()
+		c.Minimum, c.Maximum, c.Closed, err = sceneBounds(item, kind)
+		s = c
+	case "cone":
+		c := NewCone()
+		c.Minimum, c.Maximum, c.Closed, err = sceneBounds(item, kind)
+		s = c
+	case "triangle":
+		s, err = sceneTriangle(item)
+	case "group":
+		s, err = l.group(item, m)
+	case "csg":
+		s, err = l.csg(item, m)
+	case "obj":
+		s, err = l.obj(item, m)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if m != nil {
+		s.SetMaterial(m)
+	}
+
+	if v := mappingValue(item, "transform"); v != nil {
+		t, err := l.transform(v)
+		if err != nil {
+			return nil, err
+		}
+		if !isFinite(t) || !t.IsInvertable() {
+			return nil, nodeError(v, ErrInvalidValue, "transform can't be inverted, is something scaled by 0?")
+		}
+		s.SetTransform(t)
+	}
+
+	if v := mappingValue(item, "shadow"); v != nil {
+		b, err := sceneBool(v)
+		if err != nil {
+			return nil, err
+		}
+		s.SetCastsShadow(b)
+	}
+
+	return s, nil
+}
+
+func sceneBounds(item *yaml.Node, kind string) (min, max float64, closed bool, err error) {
+	min, max = math.Inf(-1), math.Inf(1)
+
+	if v := mappingValue(item, "min"); v != nil {
+		if min, err = sceneFloat(v); err != nil {
+			return
+		}
+	}
+
+	if v := mappingValue(item, "max"); v != nil {
+		if max, err = sceneFloat(v); err != nil {
+			return
+		}
+	}
+
+	// Only the bounded ends of a closed shape get caps
+	if v := mappingValue(item, "closed"); v != nil {
+		if closed, err = sceneBool(v); err != nil {
+			return
+		}
+	}
+
+	if min > max {
+		err = nodeError(item, ErrInvalidValue, "%s min is greater than max", kind)
+	}
+
+	return
+}
+
+func sceneTriangle(item *yaml.Node) (Shape, error) {
+	ps := make([]*Point, 3)
+
+	for i, k := range []string{"p1", "p2", "p3"} {
+		v := mappingValue(item, k)
+		if v == nil {
+			return nil, nodeError(item, ErrMissingKey, "triangle needs %s", k)
+		}
+
+		var err error
+		if ps[i], err = scenePoint(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewTriangle(ps[0], ps[1], ps[2]), nil
+}
+
+func (l *sceneLoader) group(item *yaml.Node, m *Material) (Shape, error) {
+	g := NewGroup()
+
+	children := mappingValue(item, "children")
+	if children == nil {
+		return g, nil
+	}
+
+	if children.Kind != yaml.SequenceNode {
+		return nil, nodeError(children, ErrInvalidValue, "children must be a list")
+	}
+
+	for _, c := range children.Content {
+		s, err := l.child(c, "each child", m)
+		if err != nil {
+			return nil, err
+		}
+		g.AddChild(s)
+	}
+
+	return g, nil
+}
+
+var sceneCSGOperations = map[string]CSGOperation{
+	"union":        CSG_UNION,
+	"intersection": CSG_INTERSECTION,
+	"difference":   CSG_DIFFERENCE,
+}
+
+func (l *sceneLoader) csg(item *yaml.Node, m *Material) (Shape, error) {
+	v := mappingValue(item, "operation")
+	if v == nil {
+		return nil, nodeError(item, ErrMissingKey, "csg needs an operation")
+	}
+
+	op, ok := sceneCSGOperations[v.Value]
+	if !ok {
+		return nil, nodeError(v, ErrInvalidValue, "unknown csg operation %q, expected union, intersection or difference", v.Value)
+	}
+
+	sides := make([]Shape, 2)
+	for i, k := range []string{"left", "right"} {
+		n := mappingValue(item, k)
+		if n == nil {
+			return nil, nodeError(item, ErrMissingKey, "csg needs %s", k)
+		}
+
+		var err error
+		if sides[i], err = l.child(n, "csg "+k, m); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewCSG(op, sides[0], sides[1]), nil
+}
+
+// child builds a shape nested inside a group or csg
+func (l *sceneLoader) child(n *yaml.Node, what string, m *Material) (Shape, error) {
+	if n.Kind != yaml.MappingNode || mappingValue(n, "add") == nil {
+		return nil, nodeError(n, ErrInvalidValue, "%s must be an add item", what)
+	}
+	return l.shape(n, m)
+}
+
+func (l *sceneLoader) obj(item *yaml.Node, m *Material) (Shape, error) {
+	v := mappingValue(item, "file")
+	if v == nil {
+		return nil, nodeError(item, ErrMissingKey, "obj needs a file")
+	}
+
+	f, err := os.Open(path.Join(l.dir, v.Value))
+	if err != nil {
+		return nil, nodeError(v, ErrInvalidValue, "%s", err.Error())
+	}
+	defer f.Close()
+
+	p, err := ParseObj(f)
+	if err != nil {
+		return nil, nodeError(v, ErrInvalidValue, "%s: %s", v.Value, err.Error())
+	}
+
+	g := p.ToGroup()
+	if m != nil {
+		setMaterialAll(g, m)
+	}
+
+	return g, nil
+}
+
+// setMaterialAll sets the material of an obj group and everything inside it
+func setMaterialAll(s Shape, m *Material) {
+	s.SetMaterial(m)
+
+	if g, ok := s.(*Group); ok {
+		for _, child := range g.Children {
+			setMaterialAll(child, m)
+		}
+	}
+}
+
+// material reads either the name of a define or a mapping of material keys
+func (l *sceneLoader) material(n *yaml.Node) (*Material, error) {
+	if n.Kind == yaml.ScalarNode {
+		d, done, err := l.lookup(n)
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+
+		return l.material(d)
+	}
+
+	if n.Kind != yaml.MappingNode {
+		return nil, nodeError(n, ErrInvalidValue, "material must be a define or a mapping")
+	}
+
+	if err := checkKeys(n, "material", sceneMaterialKeys...); err != nil {
+		return nil, err
+	}
+
+	m := NewMaterial()
+
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, v := n.Content[i].Value, n.Content[i+1]
+
+		var err error
+
+		switch k {
+		case "color":
+			m.Color, err = sceneColor(v)
+		case "ambient":
+			m.Ambient, err = sceneFloat(v)
+		case "diffuse":
+			m.Diffuse, err = sceneFloat(v)
+		case "specular":
+			m.Specular, err = sceneFloat(v)
+		case "shininess":
+			m.Shininess, err = sceneFloat(v)
+		case "reflective":
+			m.Reflective, err = sceneFloat(v)
+		case "transparency":
+			m.Transparency, err = sceneFloat(v)
+		case "refractive-index":
+			m.RefractiveIndex, err = sceneFloat(v)
+		case "pattern":
+			m.Pattern, err = l.pattern(v)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// transform reads a list of operations, which are applied in the order
+// they are listed. Items may also name a define holding another list.
+func (l *sceneLoader) transform(n *yaml.Node) (*Transform, error) {
+	if n.Kind != yaml.SequenceNode {
+		return nil, nodeError(n, ErrInvalidValue, "transform must be a list")
+	}
+
+	t := NewTransform()
+
+	for _, op := range n.Content {
+		var m *Transform
+		var err error
+
+		if op.Kind == yaml.ScalarNode {
+			m, err = l.transformDefine(op)
+		} else {
+			m, err = sceneTransformOp(op)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Each op is applied after the ones before it, so goes on the left
+		m.IMulti(t)
+		t = m
+	}
+
+	return t, nil
+}
+
+func (l *sceneLoader) transformDefine(n *yaml.Node) (*Transform, error) {
+	d, done, err := l.lookup(n)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	return l.transform(d)
+}
+
+func sceneTransformOp(op *yaml.Node) (*Transform, error) {
+	if op.Kind != yaml.SequenceNode || len(op.Content) == 0 {
+		return nil, nodeError(op, ErrInvalidValue, "transform must be a define or a list like [ translate, 1, 2, 3 ]")
+	}
+
+	nameNode := op.Content[0]
+	name := nameNode.Value
+
+	n, ok := sceneTransformArity[name]
+	if !ok {
+		return nil, nodeError(nameNode, ErrUnknownTransform, "unknown transform %s", name)
+	}
+
+	if len(op.Content)-1 != n {
+		return nil, nodeError(op, ErrTransformArity, "%s takes %d values, got %d", name, n, len(op.Content)-1)
+	}
+
+	args := make([]float64, n)
+
+	for i, a := range op.Content[1:] {
+		var err error
+		if args[i], err = sceneFloat(a); err != nil {
+			return nil, err
+		}
+	}
+
+	t := NewTransform()
+
+	switch name {
+	case "translate":
+		t.Translate(args[0], args[1], args[2])
+	case "scale":
+		t.Scale(args[0], args[1], args[2])
+	case "rotate-x":
+		t.RotateX(args[0])
+	case "rotate-y":
+		t.RotateY(args[0])
+	case "rotate-z":
+		t.RotateZ(args[0])
+	case "shear":
+		t.Sheer(args[0], args[1], args[2], args[3], args[4], args[5])
+	}
+
+	return t, nil
+}
+
+func isFinite(t *Transform) bool {
+	for _, v := range t.Data() {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func sceneFloat(n *yaml.Node) (float64, error) {
+	if n.Kind != yaml.ScalarNode {
+		return 0, nodeError(n, ErrInvalidValue, "expected a number")
+	}
+
+	f, err := strconv.ParseFloat(n.Value, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, nodeError(n, ErrInvalidValue, "expected a number, got %q", n.Value)
+	}
+
+	return f, nil
+}
+
+func sceneInt(n *yaml.Node) (int, error) {
+	if n.Kind != yaml.ScalarNode {
+		return 0, nodeError(n, ErrInvalidValue, "expected an integer")
+	}
+
+	i, err := strconv.Atoi(n.Value)
+	if err != nil {
+		return 0, nodeError(n, ErrInvalidValue, "expected an integer, got %q", n.Value)
+	}
+
+	return i, nil
+}
+
+func sceneBool(n *yaml.Node) (bool, error) {
+	var b bool
+	if n.Kind != yaml.ScalarNode || n.Decode(&b) != nil {
+		return false, nodeError(n, ErrInvalidValue, "expected true or false, got %q", n.Value)
+	}
+	return b, nil
+}
+
+func sceneTriple(n *yaml.Node) (x, y, z float64, err error) {
+	if n.Kind != yaml.SequenceNode || len(n.Content) != 3 {
+		return 0, 0, 0, nodeError(n, ErrInvalidValue, "expected a list of 3 numbers")
+	}
+
+	if x, err = sceneFloat(n.Content[0]); err != nil {
+		return
+	}
+	if y, err = sceneFloat(n.Content[1]); err != nil {
+		return
+	}
+	z, err = sceneFloat(n.Content[2])
+
+	return
+}
+
+func scenePoint(n *yaml.Node) (*Point, error) {
+	x, y, z, err := sceneTriple(n)
+	if err != nil {
+		return nil, err
+	}
+	return NewPoint(x, y, z), nil
+}
+
+func sceneVector(n *yaml.Node) (*Vector, error) {
+	x, y, z, err := sceneTriple(n)
+	if err != nil {
+		return nil, err
+	}
+	return NewVector(x, y, z), nil
+}
+
+func sceneColor(n *yaml.Node) (*Color, error) {
+	r, g, b, err := sceneTriple(n)
+	if err != nil {
+		return nil, err
+	}
+	return NewColor(r, g, b, 1), nil
+}
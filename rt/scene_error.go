@@ -0,0 +1,164 @@
+package rt
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kinds of scene error, use errors.Is to check which a SceneError is
+var (
+	ErrSceneSyntax      = errors.New("invalid yaml")
+	ErrSceneNoCamera    = errors.New("no camera")
+	ErrUnknownItem      = errors.New("unknown item")
+	ErrUnknownShape     = errors.New("unknown shape")
+	ErrUnknownKey       = errors.New("unknown key")
+	ErrDuplicateKey     = errors.New("duplicate key")
+	ErrMissingKey       = errors.New("missing key")
+	ErrInvalidValue     = errors.New("invalid value")
+	ErrUnknownTransform = errors.New("unknown transform")
+	ErrTransformArity   = errors.New("wrong number of transform values")
+	ErrUnknownDefine    = errors.New("unknown define")
+	ErrDuplicateDefine  = errors.New("duplicate define")
+	ErrRecursiveDefine  = errors.New("recursive define")
+)
+
+// SceneError points at where in a scene file something went wrong. Line
+// and Column are 1 based, and 0 if the error isn't tied to a position.
+type SceneError struct {
+	File   string
+	Line   int
+	Column int
+	Err    error
+	Msg    string
+}
+
+func (e *SceneError) Error() string {
+	f := e.File
+	if f == "" {
+		f = "<scene>"
+	}
+
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", f, e.Msg)
+	}
+
+	return fmt.Sprintf("%s:%d:%d: %s", f, e.Line, e.Column, e.Msg)
+}
+
+func (e *SceneError) Unwrap() error {
+	return e.Err
+}
+
+// nodeError creates a SceneError at the position of the node, the file is
+// filled in once it makes its way back out of the loader
+func nodeError(n *yaml.Node, kind error, format string, args ...interface{}) error {
+	e := SceneError{
+		Err: kind,
+		Msg: fmt.Sprintf(format, args...),
+	}
+
+	if n != nil {
+		e.Line = n.Line
+		e.Column = n.Column
+	}
+
+	return &e
+}
+
+var yamlLineRegex = regexp.MustCompile(`line (\d+)`)
+
+// syntaxError wraps an error from the yaml decoder, which only gives the
+// line as part of its message
+func syntaxError(err error) error {
+	e := SceneError{
+		Err: ErrSceneSyntax,
+		Msg: err.Error(),
+	}
+
+	if m := yamlLineRegex.FindStringSubmatch(err.Error()); m != nil {
+		e.Line, _ = strconv.Atoi(m[1])
+		e.Column = 1
+	}
+
+	return &e
+}
+
+// checkKeys returns an error for the first key in the mapping that is
+// repeated or isn't allowed, suggesting the closest allowed key for likely typos
+func checkKeys(n *yaml.Node, what string, allowed ...string) error {
+	seen := map[string]bool{}
+
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k := n.Content[i]
+
+		if seen[k.Value] {
+			return nodeError(k, ErrDuplicateKey, "duplicate %s key %q", what, k.Value)
+		}
+		seen[k.Value] = true
+
+		found := false
+		for _, a := range allowed {
+			if k.Value == a {
+				found = true
+				break
+			}
+		}
+
+		if found {
+			continue
+		}
+
+		if s := closestKey(k.Value, allowed); s != "" {
+			return nodeError(k, ErrUnknownKey, "unknown %s key %q, did you mean %q?", what, k.Value, s)
+		}
+		return nodeError(k, ErrUnknownKey, "unknown %s key %q", what, k.Value)
+	}
+
+	return nil
+}
+
+// closestKey returns the allowed key within a couple of edits of k, if any
+func closestKey(k string, allowed []string) string {
+	best, bestDist := "", 3
+	for _, a := range allowed {
+		if d := editDistance(k, a); d < bestDist {
+			best, bestDist = a, d
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, minInt(cur[j-1]+1, prev[j-1]+cost))
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
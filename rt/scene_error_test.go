@@ -0,0 +1,287 @@
+package rt
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// loadSceneError loads a scene that should fail, returning its SceneError
+func loadSceneError(t *testing.T, scene string) *SceneError {
+	w, c, err := LoadScene(strings.NewReader(scene))
+
+	assert.Nil(t, w)
+	assert.Nil(t, c)
+
+	var se *SceneError
+	if !assert.True(t, errors.As(err, &se), "expected a SceneError, got %v", err) {
+		return &SceneError{}
+	}
+	return se
+}
+
+func TestSceneErrorFormat(t *testing.T) {
+	e := &SceneError{File: "cover.yml", Line: 3, Column: 5, Err: ErrUnknownShape, Msg: "unknown shape teapot"}
+	assert.Equal(t, "cover.yml:3:5: unknown shape teapot", e.Error())
+	assert.True(t, errors.Is(e, ErrUnknownShape))
+
+	e = &SceneError{Err: ErrSceneNoCamera, Msg: "scene has no camera"}
+	assert.Equal(t, "<scene>: scene has no camera", e.Error())
+}
+
+func TestSceneErrorNoCamera(t *testing.T) {
+	e := loadSceneError(t, "- add: sphere")
+
+	assert.True(t, errors.Is(e, ErrSceneNoCamera))
+}
+
+func TestSceneErrorSyntax(t *testing.T) {
+	e := loadSceneError(t, "- add: sphere\n  material: [ 1, 2\n")
+
+	assert.True(t, errors.Is(e, ErrSceneSyntax))
+	assert.Greater(t, e.Line, 0)
+}
+
+func TestSceneErrorUnknownShape(t *testing.T) {
+	e := loadSceneError(t, testSceneCamera+"- add: teapot\n")
+
+	assert.True(t, errors.Is(e, ErrUnknownShape))
+	assert.Equal(t, 9, e.Line)
+	assert.Equal(t, 8, e.Column)
+	assert.Equal(t, "<scene>:9:8: unknown shape teapot", e.Error())
+
+	// The shape is checked before its material
+	e = loadSceneError(t, testSceneCamera+`
+- add: teapot
+  material:
+    colour: [ 1, 0, 0 ]
+`)
+	assert.True(t, errors.Is(e, ErrUnknownShape))
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: group
+  wibble: true
+  material:
+    colour: [ 1, 0, 0 ]
+`)
+	assert.True(t, errors.Is(e, ErrUnknownKey))
+	assert.Equal(t, `unknown group key "wibble"`, e.Msg)
+}
+
+func TestSceneErrorUnknownKey(t *testing.T) {
+	e := loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material:
+    color: [ 1, 0, 0 ]
+    difuse: 0.7
+`)
+
+	assert.True(t, errors.Is(e, ErrUnknownKey))
+	assert.Equal(t, 13, e.Line)
+	assert.Equal(t, 5, e.Column)
+	assert.Contains(t, e.Msg, `did you mean "diffuse"?`)
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: sphere
+  wibble: true
+`)
+
+	assert.True(t, errors.Is(e, ErrUnknownKey))
+	assert.Equal(t, `unknown sphere key "wibble"`, e.Msg)
+}
+
+// A key given twice is an error at the second one
+func TestSceneErrorDuplicateKey(t *testing.T) {
+	e := loadSceneError(t, `
+- add: camera
+  width: 100
+  height: 50
+  width: 200
+  field-of-view: 0.785
+  from: [ 0, 0, -5 ]
+  to: [ 0, 0, 0 ]
+  up: [ 0, 1, 0 ]
+`)
+
+	assert.True(t, errors.Is(e, ErrDuplicateKey))
+	assert.Equal(t, 5, e.Line)
+	assert.Equal(t, 3, e.Column)
+	assert.Equal(t, `<scene>:5:3: duplicate camera key "width"`, e.Error())
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material:
+    color: [ 1, 0, 0 ]
+    color: [ 0, 1, 0 ]
+`)
+
+	assert.True(t, errors.Is(e, ErrDuplicateKey))
+	assert.Equal(t, 13, e.Line)
+}
+
+func TestSceneErrorTransformArity(t *testing.T) {
+	e := loadSceneError(t, testSceneCamera+`
+- add: sphere
+  transform:
+    - [ translate, 1, 2 ]
+`)
+
+	assert.True(t, errors.Is(e, ErrTransformArity))
+	assert.Equal(t, 12, e.Line)
+	assert.Equal(t, "translate takes 3 values, got 2", e.Msg)
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: sphere
+  transform:
+    - [ squish, 1 ]
+`)
+
+	assert.True(t, errors.Is(e, ErrUnknownTransform))
+}
+
+func TestSceneErrorRecursiveDefine(t *testing.T) {
+	e := loadSceneError(t, testSceneCamera+`
+- define: loop
+  value:
+    - [ scale, 2, 2, 2 ]
+    - loop
+- add: sphere
+  transform:
+    - loop
+`)
+
+	assert.True(t, errors.Is(e, ErrRecursiveDefine))
+	assert.Equal(t, 13, e.Line)
+
+	e = loadSceneError(t, testSceneCamera+`
+- define: shiny
+  extend: shiny
+  value:
+    specular: 1
+`)
+
+	assert.True(t, errors.Is(e, ErrRecursiveDefine))
+}
+
+func TestSceneErrorUnknownDefine(t *testing.T) {
+	e := loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material: missing
+`)
+
+	assert.True(t, errors.Is(e, ErrUnknownDefine))
+	assert.Equal(t, 11, e.Line)
+	assert.Equal(t, 13, e.Column)
+}
+
+// A define name can only be used once
+func TestSceneErrorDuplicateDefine(t *testing.T) {
+	e := loadSceneError(t, testSceneCamera+`
+- define: shiny
+  value:
+    specular: 1
+- define: shiny
+  value:
+    specular: 0.5
+`)
+
+	assert.True(t, errors.Is(e, ErrDuplicateDefine))
+	assert.Equal(t, 13, e.Line)
+	assert.Equal(t, 11, e.Column)
+	assert.Equal(t, "define shiny is already defined", e.Msg)
+}
+
+// Defines must be given a name
+func TestSceneErrorDefineName(t *testing.T) {
+	e := loadSceneError(t, testSceneCamera+`
+- define:
+  value:
+    specular: 1
+`)
+
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+	assert.Equal(t, "define needs a name", e.Msg)
+
+	e = loadSceneError(t, testSceneCamera+`
+- define: [ shiny ]
+  value:
+    specular: 1
+`)
+
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+	assert.Equal(t, 10, e.Line)
+}
+
+// Mistakes that would otherwise panic in the matrix code are errors
+func TestSceneErrorInvalidValues(t *testing.T) {
+	e := loadSceneError(t, testSceneCamera+`
+- add: sphere
+  transform:
+    - [ scale, 0, 1, 1 ]
+`)
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+
+	e = loadSceneError(t, `
+- add: camera
+  width: 100
+  height: 100
+  field-of-view: 0.785
+  from: [ 0, 0, 0 ]
+  to: [ 0, 0, 0 ]
+  up: [ 0, 1, 0 ]
+`)
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: light
+  at: [ 0, 0 ]
+  intensity: [ 1, 1, 1 ]
+`)
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: light
+  at: [ 0, 0, 0 ]
+`)
+	assert.True(t, errors.Is(e, ErrMissingKey))
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: cone
+  closed: true
+  min: 1
+  max: -1
+`)
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+	assert.Equal(t, "cone min is greater than max", e.Msg)
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: csg
+  operation: subtract
+  left:
+    add: sphere
+  right:
+    add: cube
+`)
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: csg
+  operation: union
+  left:
+    add: sphere
+`)
+	assert.True(t, errors.Is(e, ErrMissingKey))
+}
+
+func TestSceneErrorFile(t *testing.T) {
+	_, _, err := LoadSceneFile("../files/cover.yml")
+	assert.NoError(t, err)
+
+	_, _, err = LoadSceneFile("../files/triangles.obj")
+
+	var se *SceneError
+	assert.True(t, errors.As(err, &se))
+	assert.Equal(t, "../files/triangles.obj", se.File)
+}
@@ -0,0 +1,297 @@
+package rt
+
+import (
+	"path"
+
+	"gopkg.in/yaml.v3"
+)
+
+// pattern reads either the name of a define or a mapping with the pattern
+// type, its colors or sub patterns and an optional transform
+func (l *sceneLoader) pattern(n *yaml.Node) (Pattern, error) {
+	if n.Kind == yaml.ScalarNode {
+		d, done, err := l.lookup(n)
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+
+		return l.pattern(d)
+	}
+
+	if n.Kind != yaml.MappingNode {
+		return nil, nodeError(n, ErrInvalidValue, "pattern must be a define or a mapping")
+	}
+
+	kindNode := mappingValue(n, "type")
+	if kindNode == nil {
+		return nil, nodeError(n, ErrMissingKey, "pattern needs a type")
+	}
+
+	var p Pattern
+	var err error
+
+	switch kind := kindNode.Value; kind {
+	case "stripes", "gradient", "rings", "checkers":
+		if err = checkKeys(n, kind+" pattern", "type", "colors", "patterns", "transform"); err != nil {
+			return nil, err
+		}
+
+		var a, b Pattern
+		if a, b, err = l.patternPair(n); err != nil {
+			return nil, err
+		}
+
+		switch kind {
+		case "stripes":
+			p = NewNestedStripePattern(a, b)
+		case "gradient":
+			p = NewNestedGradientPattern(a, b)
+		case "rings":
+			p = NewNestedRingPattern(a, b)
+		case "checkers":
+			p = NewNestedCheckerPattern(a, b)
+		}
+	case "blended":
+		p, err = l.blendedPattern(n)
+	case "perturbed":
+		p, err = l.perturbedPattern(n)
+	case "map":
+		p, err = l.mapPattern(n)
+	default:
+		return nil, nodeError(kindNode, ErrInvalidValue, "unknown pattern type %s", kindNode.Value)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if v := mappingValue(n, "transform"); v != nil {
+		t, err := l.transform(v)
+		if err != nil {
+			return nil, err
+		}
+		if !isFinite(t) || !t.IsInvertable() {
+			return nil, nodeError(v, ErrInvalidValue, "transform can't be inverted, is something scaled by 0?")
+		}
+		p.SetTransform(t)
+	}
+
+	return p, nil
+}
+
+// patternPair reads the two halves of a pattern, either as a pair of
+// colors or a pair of nested patterns
+func (l *sceneLoader) patternPair(n *yaml.Node) (a, b Pattern, err error) {
+	colors := mappingValue(n, "colors")
+	patterns := mappingValue(n, "patterns")
+
+	switch {
+	case colors != nil && patterns != nil:
+		return nil, nil, nodeError(patterns, ErrInvalidValue, "pattern can have colors or patterns, not both")
+	case colors != nil:
+		ca, cb, err := sceneColorPair(colors)
+		if err != nil {
+			return nil, nil, err
+		}
+		return NewSolidPattern(ca), NewSolidPattern(cb), nil
+	case patterns != nil:
+		if patterns.Kind != yaml.SequenceNode || len(patterns.Content) != 2 {
+			return nil, nil, nodeError(patterns, ErrInvalidValue, "pattern patterns must be a list of 2 patterns")
+		}
+
+		if a, err = l.pattern(patterns.Content[0]); err != nil {
+			return
+		}
+		b, err = l.pattern(patterns.Content[1])
+		return
+	}
+
+	return nil, nil, nodeError(n, ErrMissingKey, "pattern needs colors or patterns")
+}
+
+func sceneColorPair(n *yaml.Node) (a, b *Color, err error) {
+	if n.Kind != yaml.SequenceNode || len(n.Content) != 2 {
+		return nil, nil, nodeError(n, ErrInvalidValue, "pattern colors must be a list of 2 colors")
+	}
+
+	if a, err = sceneColor(n.Content[0]); err != nil {
+		return
+	}
+	b, err = sceneColor(n.Content[1])
+
+	return
+}
+
+func (l *sceneLoader) blendedPattern(n *yaml.Node) (Pattern, error) {
+	if err := checkKeys(n, "blended pattern", "type", "patterns", "weight", "transform"); err != nil {
+		return nil, err
+	}
+
+	if mappingValue(n, "patterns") == nil {
+		return nil, nodeError(n, ErrMissingKey, "blended pattern needs patterns")
+	}
+
+	a, b, err := l.patternPair(n)
+	if err != nil {
+		return nil, err
+	}
+
+	p := NewBlendedPattern(a, b)
+
+	if v := mappingValue(n, "weight"); v != nil {
+		if p.Weight, err = sceneFloat(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+func (l *sceneLoader) perturbedPattern(n *yaml.Node) (Pattern, error) {
+	if err := checkKeys(n, "perturbed pattern", "type", "pattern", "scale", "transform"); err != nil {
+		return nil, err
+	}
+
+	v := mappingValue(n, "pattern")
+	if v == nil {
+		return nil, nodeError(n, ErrMissingKey, "perturbed pattern needs a pattern")
+	}
+
+	inner, err := l.pattern(v)
+	if err != nil {
+		return nil, err
+	}
+
+	p := NewPerturbedPattern(inner, DEFAULT_PERTURB_SCALE)
+
+	if v := mappingValue(n, "scale"); v != nil {
+		if p.Scale, err = sceneFloat(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+var sceneUVMappings = map[string]UVMapping{
+	"spherical":   SphericalMap,
+	"planar":      PlanarMap,
+	"cylindrical": CylindricalMap,
+}
+
+var sceneCubeFaces = []string{"left", "right", "front", "back", "up", "down"}
+
+// mapPattern reads a texture map, which is either a mapping with a single
+// uv_pattern or a cube mapping with a uv pattern for each face
+func (l *sceneLoader) mapPattern(n *yaml.Node) (Pattern, error) {
+	m := mappingValue(n, "mapping")
+	if m == nil {
+		return nil, nodeError(n, ErrMissingKey, "map pattern needs a mapping")
+	}
+
+	if m.Value == "cube" {
+		if err := checkKeys(n, "cube map pattern", append([]string{"type", "mapping", "transform"}, sceneCubeFaces...)...); err != nil {
+			return nil, err
+		}
+
+		var faces [6]UVPattern
+		for i, k := range sceneCubeFaces {
+			v := mappingValue(n, k)
+			if v == nil {
+				return nil, nodeError(n, ErrMissingKey, "cube map pattern needs %s", k)
+			}
+
+			var err error
+			if faces[i], err = l.uvPattern(v); err != nil {
+				return nil, err
+			}
+		}
+
+		return NewCubeMapPattern(faces[0], faces[1], faces[2], faces[3], faces[4], faces[5]), nil
+	}
+
+	if err := checkKeys(n, "map pattern", "type", "mapping", "uv_pattern", "transform"); err != nil {
+		return nil, err
+	}
+
+	mapping, ok := sceneUVMappings[m.Value]
+	if !ok {
+		return nil, nodeError(m, ErrInvalidValue, "unknown mapping %s, expected spherical, planar, cylindrical or cube", m.Value)
+	}
+
+	v := mappingValue(n, "uv_pattern")
+	if v == nil {
+		return nil, nodeError(n, ErrMissingKey, "map pattern needs a uv_pattern")
+	}
+
+	uv, err := l.uvPattern(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTextureMapPattern(uv, mapping), nil
+}
+
+// uvPattern reads either a checkers pattern or an image loaded from a file
+// relative to the scene
+func (l *sceneLoader) uvPattern(n *yaml.Node) (UVPattern, error) {
+	if n.Kind != yaml.MappingNode {
+		return nil, nodeError(n, ErrInvalidValue, "uv pattern must be a mapping")
+	}
+
+	kindNode := mappingValue(n, "type")
+	if kindNode == nil {
+		return nil, nodeError(n, ErrMissingKey, "uv pattern needs a type")
+	}
+
+	switch kindNode.Value {
+	case "checkers":
+		if err := checkKeys(n, "checkers uv pattern", "type", "width", "height", "colors"); err != nil {
+			return nil, err
+		}
+
+		size := make([]int, 2)
+		for i, k := range []string{"width", "height"} {
+			v := mappingValue(n, k)
+			if v == nil {
+				return nil, nodeError(n, ErrMissingKey, "checkers uv pattern needs %s", k)
+			}
+
+			var err error
+			if size[i], err = sceneInt(v); err != nil {
+				return nil, err
+			}
+		}
+
+		v := mappingValue(n, "colors")
+		if v == nil {
+			return nil, nodeError(n, ErrMissingKey, "checkers uv pattern needs colors")
+		}
+
+		a, b, err := sceneColorPair(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewUVCheckersPattern(size[0], size[1], a, b), nil
+	case "image":
+		if err := checkKeys(n, "image uv pattern", "type", "file"); err != nil {
+			return nil, err
+		}
+
+		v := mappingValue(n, "file")
+		if v == nil {
+			return nil, nodeError(n, ErrMissingKey, "image uv pattern needs a file")
+		}
+
+		c, err := LoadCanvasFile(path.Join(l.dir, v.Value))
+		if err != nil {
+			return nil, nodeError(v, ErrInvalidValue, "%s", err.Error())
+		}
+
+		return NewUVImagePattern(c), nil
+	}
+
+	return nil, nodeError(kindNode, ErrInvalidValue, "unknown uv pattern type %s", kindNode.Value)
+}
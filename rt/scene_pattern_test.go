@@ -0,0 +1,282 @@
+package rt
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Materials can carry a pattern, either inline or by naming a define
+func TestScenePattern(t *testing.T) {
+	scene := testSceneCamera + `
+- define: floor-pattern
+  value:
+    type: checkers
+    colors:
+      - [ 1, 1, 1 ]
+      - [ 0, 0, 0 ]
+- add: plane
+  material:
+    pattern: floor-pattern
+- add: sphere
+  material:
+    pattern:
+      type: stripes
+      colors:
+        - [ 1, 0, 0 ]
+        - [ 0, 0, 1 ]
+      transform:
+        - [ scale, 0.25, 0.25, 0.25 ]
+- add: sphere
+  material:
+    pattern:
+      type: gradient
+      colors: [ [ 1, 0, 0 ], [ 0, 0, 1 ] ]
+- add: sphere
+  material:
+    pattern:
+      type: rings
+      colors: [ [ 1, 0, 0 ], [ 0, 0, 1 ] ]
+`
+	w, _, err := LoadScene(strings.NewReader(scene))
+	assert.NoError(t, err)
+	assert.Len(t, w.Objects, 4)
+
+	checker := w.Objects[0].Material().Pattern.(*CheckerPattern)
+	assert.True(t, NewColor(1, 1, 1, 1).Equals(checker.A.(*SolidPattern).Color))
+	assert.True(t, NewColor(0, 0, 0, 1).Equals(checker.B.(*SolidPattern).Color))
+
+	stripes := w.Objects[1].Material().Pattern.(*StripePattern)
+	assert.True(t, NewColor(1, 0, 0, 1).Equals(stripes.A.(*SolidPattern).Color))
+	assert.True(t, stripes.Transform().Equal(NewTransform().Scale(0.25, 0.25, 0.25)))
+
+	assert.IsType(t, &GradientPattern{}, w.Objects[2].Material().Pattern)
+	assert.IsType(t, &RingPattern{}, w.Objects[3].Material().Pattern)
+}
+
+func TestScenePatternErrors(t *testing.T) {
+	e := loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material:
+    pattern:
+      type: polka-dots
+      colors: [ [ 1, 0, 0 ], [ 0, 0, 1 ] ]
+`)
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+	assert.Equal(t, 13, e.Line)
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material:
+    pattern:
+      type: stripes
+      colors: [ [ 1, 0, 0 ] ]
+`)
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material:
+    pattern:
+      type: stripes
+`)
+	assert.True(t, errors.Is(e, ErrMissingKey))
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material:
+    pattern:
+      type: stripes
+      colours: [ [ 1, 0, 0 ], [ 0, 0, 1 ] ]
+`)
+	assert.True(t, errors.Is(e, ErrUnknownKey))
+}
+
+// Patterns can be built out of other patterns
+func TestSceneCompositePattern(t *testing.T) {
+	scene := testSceneCamera + `
+- define: red-stripes
+  value:
+    type: stripes
+    colors: [ [ 1, 0, 0 ], [ 1, 1, 1 ] ]
+- add: plane
+  material:
+    pattern:
+      type: checkers
+      patterns:
+        - red-stripes
+        - type: rings
+          colors: [ [ 0, 0, 1 ], [ 1, 1, 1 ] ]
+- add: sphere
+  material:
+    pattern:
+      type: blended
+      weight: 0.25
+      patterns:
+        - red-stripes
+        - type: stripes
+          colors: [ [ 0, 1, 0 ], [ 1, 1, 1 ] ]
+          transform:
+            - [ rotate-y, 1.5708 ]
+- add: sphere
+  material:
+    pattern:
+      type: perturbed
+      scale: 0.1
+      pattern:
+        type: gradient
+        colors: [ [ 1, 0, 0 ], [ 0, 0, 1 ] ]
+`
+	w, _, err := LoadScene(strings.NewReader(scene))
+	assert.NoError(t, err)
+	assert.Len(t, w.Objects, 3)
+
+	checker := w.Objects[0].Material().Pattern.(*CheckerPattern)
+	assert.IsType(t, &StripePattern{}, checker.A)
+	assert.IsType(t, &RingPattern{}, checker.B)
+
+	blend := w.Objects[1].Material().Pattern.(*BlendedPattern)
+	assert.Equal(t, 0.25, blend.Weight)
+	assert.IsType(t, &StripePattern{}, blend.B)
+
+	perturbed := w.Objects[2].Material().Pattern.(*PerturbedPattern)
+	assert.Equal(t, 0.1, perturbed.Scale)
+	assert.IsType(t, &GradientPattern{}, perturbed.Pattern)
+}
+
+func TestSceneCompositePatternErrors(t *testing.T) {
+	e := loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material:
+    pattern:
+      type: checkers
+      colors: [ [ 1, 0, 0 ], [ 0, 0, 1 ] ]
+      patterns: [ { type: stripes, colors: [ [ 1, 0, 0 ], [ 0, 0, 1 ] ] } ]
+`)
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material:
+    pattern:
+      type: perturbed
+      scale: 0.1
+`)
+	assert.True(t, errors.Is(e, ErrMissingKey))
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material:
+    pattern:
+      type: blended
+      colors: [ [ 1, 0, 0 ], [ 0, 0, 1 ] ]
+`)
+	assert.True(t, errors.Is(e, ErrUnknownKey))
+
+	e = loadSceneError(t, testSceneCamera+`
+- define: loop
+  value:
+    type: checkers
+    patterns: [ loop, loop ]
+- add: sphere
+  material:
+    pattern: loop
+`)
+	assert.True(t, errors.Is(e, ErrRecursiveDefine))
+}
+
+// Texture maps read their images relative to the scene file
+func TestSceneMapPattern(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "earth.ppm"), []byte("P3\n2 1\n255\n0 0 255 0 255 0\n"), 0644))
+
+	scene := testSceneCamera + `
+- add: sphere
+  material:
+    pattern:
+      type: map
+      mapping: spherical
+      uv_pattern:
+        type: image
+        file: earth.ppm
+- add: plane
+  material:
+    pattern:
+      type: map
+      mapping: planar
+      uv_pattern:
+        type: checkers
+        width: 4
+        height: 2
+        colors: [ [ 1, 1, 1 ], [ 0, 0, 0 ] ]
+- add: cube
+  material:
+    pattern:
+      type: map
+      mapping: cube
+      left: { type: checkers, width: 1, height: 1, colors: [ [ 1, 0, 0 ], [ 1, 0, 0 ] ] }
+      right: { type: checkers, width: 1, height: 1, colors: [ [ 0, 1, 0 ], [ 0, 1, 0 ] ] }
+      front: { type: checkers, width: 1, height: 1, colors: [ [ 0, 0, 1 ], [ 0, 0, 1 ] ] }
+      back: { type: image, file: earth.ppm }
+      up: { type: checkers, width: 1, height: 1, colors: [ [ 1, 1, 0 ], [ 1, 1, 0 ] ] }
+      down: { type: checkers, width: 1, height: 1, colors: [ [ 0, 1, 1 ], [ 0, 1, 1 ] ] }
+`
+	sceneFile := filepath.Join(dir, "scene.yml")
+	assert.NoError(t, os.WriteFile(sceneFile, []byte(scene), 0644))
+
+	w, _, err := LoadSceneFile(sceneFile)
+	assert.NoError(t, err)
+	assert.Len(t, w.Objects, 3)
+
+	earth := w.Objects[0].Material().Pattern.(*TextureMapPattern)
+	img := earth.UVPattern.(*UVImagePattern)
+	assert.Equal(t, 2, img.Canvas.Width)
+
+	checkers := w.Objects[1].Material().Pattern.(*TextureMapPattern).UVPattern.(*UVCheckersPattern)
+	assert.Equal(t, 4, checkers.Width)
+	assert.Equal(t, 2, checkers.Height)
+
+	cube := w.Objects[2].Material().Pattern.(*CubeMapPattern)
+	assert.True(t, NewColor(1, 0, 0, 1).Equals(PatternAt(cube, NewPoint(-1, 0, 0))))
+	assert.True(t, NewColor(0, 1, 1, 1).Equals(PatternAt(cube, NewPoint(0, -1, 0))))
+	assert.IsType(t, &UVImagePattern{}, cube.Faces[CUBE_FACE_BACK])
+}
+
+func TestSceneMapPatternErrors(t *testing.T) {
+	e := loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material:
+    pattern:
+      type: map
+      mapping: toroidal
+      uv_pattern: { type: checkers, width: 1, height: 1, colors: [ [ 1, 0, 0 ], [ 1, 0, 0 ] ] }
+`)
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: sphere
+  material:
+    pattern:
+      type: map
+      mapping: spherical
+      uv_pattern:
+        type: image
+        file: no-such-texture.ppm
+`)
+	assert.True(t, errors.Is(e, ErrInvalidValue))
+	assert.Equal(t, 17, e.Line)
+
+	e = loadSceneError(t, testSceneCamera+`
+- add: cube
+  material:
+    pattern:
+      type: map
+      mapping: cube
+      left: { type: checkers, width: 1, height: 1, colors: [ [ 1, 0, 0 ], [ 1, 0, 0 ] ] }
+`)
+	assert.True(t, errors.Is(e, ErrMissingKey))
+}
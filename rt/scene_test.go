@@ -0,0 +1,261 @@
+package rt
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSceneCamera = `
+- add: camera
+  width: 100
+  height: 50
+  field-of-view: 0.785
+  from: [ 0, 0, -5 ]
+  to: [ 0, 0, 0 ]
+  up: [ 0, 1, 0 ]
+`
+
+func TestSceneLoadCover(t *testing.T) {
+	w, c, err := LoadSceneFile("../files/cover.yml")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 100, c.HSize)
+	assert.Equal(t, 100, c.VSize)
+	assert.Equal(t, 0.785, c.FieldOfView)
+	vt := ViewTransform(NewPoint(-6, 6, -10), NewPoint(6, 0, 6), NewVector(-0.45, 1, 0))
+	assert.True(t, c.Transform().Equal(vt))
+
+	assert.Len(t, w.Lights, 2)
+	assert.True(t, NewPoint(50, 100, -50).Equals(w.Lights[0].Position))
+	assert.True(t, NewColor(0.2, 0.2, 0.2, 1).Equals(w.Lights[1].Intensity))
+
+	// A backdrop plane, a sphere and 17 cubes
+	assert.Len(t, w.Objects, 19)
+	assert.IsType(t, &Plane{}, w.Objects[0])
+	assert.IsType(t, &Sphere{}, w.Objects[1])
+	for _, o := range w.Objects[2:] {
+		assert.IsType(t, &Cube{}, o)
+	}
+}
+
+// Defines extending another define keep the keys they don't override
+func TestSceneDefineExtend(t *testing.T) {
+	w, _, err := LoadSceneFile("../files/cover.yml")
+	assert.NoError(t, err)
+
+	// The second cube uses blue-material, which extends white-material
+	m := w.Objects[3].Material()
+	assert.True(t, NewColor(0.537, 0.831, 0.914, 1).Equals(m.Color))
+	assert.Equal(t, 0.7, m.Diffuse)
+	assert.Equal(t, 0.1, m.Ambient)
+	assert.Equal(t, 0.0, m.Specular)
+	assert.Equal(t, 0.1, m.Reflective)
+
+	glass := w.Objects[1].Material()
+	assert.Equal(t, 0.7, glass.Reflective)
+	assert.Equal(t, 0.7, glass.Transparency)
+	assert.Equal(t, 1.5, glass.RefractiveIndex)
+}
+
+// Transform lists apply in order, expanding any defines they name
+func TestSceneTransformDefines(t *testing.T) {
+	w, _, err := LoadSceneFile("../files/cover.yml")
+	assert.NoError(t, err)
+
+	// large-object is translate(1, -1, 1) then scale(0.5) then scale(3.5)
+	s := w.Objects[1]
+	e := NewTransform().Scale(3.5, 3.5, 3.5).Scale(0.5, 0.5, 0.5).Translate(1, -1, 1)
+	assert.True(t, s.Transform().Equal(e))
+
+	// The backdrop is rotated then pushed back
+	p := w.Objects[0]
+	e = NewTransform().Translate(0, 0, 500).RotateX(math.Pi / 2)
+	assert.True(t, p.Transform().Equal(e))
+}
+
+func TestSceneShapes(t *testing.T) {
+	scene := testSceneCamera + `
+- add: cylinder
+  min: -1
+  max: 2
+  closed: true
+- add: cone
+  max: 0
+  closed: true
+- add: group
+  transform:
+    - [ translate, 0, 1, 0 ]
+  children:
+    - add: sphere
+      shadow: false
+    - add: triangle
+      p1: [ 0, 1, 0 ]
+      p2: [ -1, 0, 0 ]
+      p3: [ 1, 0, 0 ]
+`
+	w, c, err := LoadScene(strings.NewReader(scene))
+	assert.NoError(t, err)
+	assert.Equal(t, 50, c.VSize)
+	assert.Len(t, w.Objects, 3)
+
+	cyl := w.Objects[0].(*Cylinder)
+	assert.Equal(t, -1.0, cyl.Minimum)
+	assert.Equal(t, 2.0, cyl.Maximum)
+	assert.True(t, cyl.Closed)
+
+	cone := w.Objects[1].(*Cone)
+	assert.True(t, math.IsInf(cone.Minimum, -1))
+	assert.Equal(t, 0.0, cone.Maximum)
+	assert.True(t, cone.Closed)
+
+	g := w.Objects[2].(*Group)
+	assert.Len(t, g.Children, 2)
+	assert.False(t, g.Children[0].CastsShadow())
+	assert.Same(t, g, g.Children[1].Parent())
+	assert.True(t, g.Transform().Equal(NewTransform().Translate(0, 1, 0)))
+}
+
+// CSGs combine their left and right shapes, which may be groups or CSGs
+func TestSceneCSG(t *testing.T) {
+	scene := testSceneCamera + `
+- add: csg
+  operation: difference
+  left:
+    add: cube
+  right:
+    add: csg
+    operation: union
+    left:
+      add: sphere
+    right:
+      add: group
+      children:
+        - add: cylinder
+`
+	w, _, err := LoadScene(strings.NewReader(scene))
+	assert.NoError(t, err)
+	assert.Len(t, w.Objects, 1)
+
+	c := w.Objects[0].(*CSG)
+	assert.Equal(t, CSG_DIFFERENCE, c.Operation)
+	assert.IsType(t, &Cube{}, c.Left)
+
+	inner := c.Right.(*CSG)
+	assert.Equal(t, CSG_UNION, inner.Operation)
+	assert.Same(t, c, inner.Parent())
+	assert.IsType(t, &Sphere{}, inner.Left)
+	assert.IsType(t, &Group{}, inner.Right)
+}
+
+// Materials on groups, csgs and objs are used by the shapes inside them
+// that don't have one of their own
+func TestSceneContainerMaterial(t *testing.T) {
+	dir := t.TempDir()
+	obj, err := os.ReadFile("../files/triangles.obj")
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "triangles.obj"), obj, 0644))
+
+	scene := testSceneCamera + `
+- add: light
+  at: [ 0, 0, -10 ]
+  intensity: [ 1, 1, 1 ]
+- add: group
+  material:
+    color: [ 1, 0, 0 ]
+  transform:
+    - [ translate, -3, 0, 0 ]
+  children:
+    - add: sphere
+    - add: sphere
+      material:
+        color: [ 0, 0, 1 ]
+      transform:
+        - [ translate, 0, 3, 0 ]
+- add: csg
+  operation: union
+  material:
+    color: [ 0, 1, 0 ]
+  left:
+    add: cube
+  right:
+    add: sphere
+  transform:
+    - [ translate, 3, 0, 0 ]
+- add: obj
+  file: triangles.obj
+  material:
+    color: [ 1, 1, 0 ]
+  transform:
+    - [ translate, 0, -3, 0 ]
+`
+	sceneFile := filepath.Join(dir, "scene.yml")
+	assert.NoError(t, os.WriteFile(sceneFile, []byte(scene), 0644))
+
+	w, _, err := LoadSceneFile(sceneFile)
+	assert.NoError(t, err)
+
+	colorAt := func(x, y float64) *Color {
+		return w.ColorAt(NewRay(NewPoint(x, y, -5), NewVector(0, 0, 1)), w.MaxDepth)
+	}
+
+	// Lit head on, so ambient plus diffuse plus specular
+	// Lit head on, so only the material's own channels come back
+	for _, tc := range []struct {
+		x, y     float64
+		expected *Color
+	}{
+		{-3, 0, NewColor(1, 0, 0, 1)},
+		{-3, 3, NewColor(0, 0, 1, 1)},
+		{3, 0, NewColor(0, 1, 0, 1)},
+		{0, -2.5, NewColor(1, 1, 0, 1)},
+	} {
+		c := colorAt(tc.x, tc.y)
+		assert.InDelta(t, tc.expected.X, c.X, 0.1)
+		assert.InDelta(t, tc.expected.Y, c.Y, 0.1)
+		assert.InDelta(t, tc.expected.Z, c.Z, 0.1)
+	}
+}
+
+// shadow: false on a group stops everything in it blocking the light
+func TestSceneGroupNoShadow(t *testing.T) {
+	scene := testSceneCamera + `
+- add: light
+  at: [ -10, 10, -10 ]
+  intensity: [ 1, 1, 1 ]
+- add: group
+  shadow: false
+  children:
+    - add: sphere
+`
+	w, _, err := LoadScene(strings.NewReader(scene))
+	assert.NoError(t, err)
+
+	assert.False(t, w.IsShadowed(NewPoint(10, -10, 10), w.Lights[0]))
+}
+
+// The loaded scene renders without any further setup
+func TestSceneRender(t *testing.T) {
+	scene := testSceneCamera + `
+- add: light
+  at: [ -10, 10, -10 ]
+  intensity: [ 1, 1, 1 ]
+- add: sphere
+  material:
+    color: [ 1, 0, 0 ]
+`
+	w, c, err := LoadScene(strings.NewReader(scene))
+	assert.NoError(t, err)
+
+	img := c.Render(w)
+	center := img.Get(50, 25)
+	corner := img.Get(0, 0)
+
+	assert.Greater(t, center.X, 0.0)
+	assert.True(t, Equal(0, center.Y))
+	assert.True(t, NewColor(0, 0, 0, 1).Equals(corner))
+}
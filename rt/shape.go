@@ -0,0 +1,139 @@
+package rt
+
+// Shape is implemented by every primitive. Shapes only need to know how to
+// intersect and compute normals in their own object space, converting to
+// and from world space is handled by Intersect and friends so new
+// primitives can be added without touching the renderer.
+type Shape interface {
+	Transform() *Transform
+	SetTransform(t *Transform)
+	Inverse() *Transform
+	InverseTrans() *Transform
+	Material() *Material
+	SetMaterial(m *Material)
+	Parent() Shape
+	SetParent(p Shape)
+	CastsShadow() bool
+	SetCastsShadow(c bool)
+	LocalIntersect(r *Ray) Intersections
+	LocalNormalAt(p *Point, hit *Intersection) *Vector
+}
+
+// Intersect converts a world space ray into the shape's object space
+// and intersects it with the shape
+func Intersect(s Shape, r *Ray) Intersections {
+	return s.LocalIntersect(r.Transform(s.Inverse()))
+}
+
+// NormalAt converts a world space point into object space, finds the local
+// normal there and converts it back to world space. The hit is passed on
+// for shapes like SmoothTriangle that need where on the surface it was.
+func NormalAt(s Shape, p *Point, hit *Intersection) *Vector {
+	op := WorldToObject(s, p)
+	on := s.LocalNormalAt(op, hit)
+	return NormalToWorld(s, on)
+}
+
+// WorldToObject converts a world space point into the shape's object
+// space, going through the space of every group above it on the way
+func WorldToObject(s Shape, p *Point) *Point {
+	if s.Parent() != nil {
+		p = WorldToObject(s.Parent(), p)
+	}
+	return s.Inverse().TMulti(p)
+}
+
+// NormalToWorld converts an object space normal into world space using the
+// inverse transpose, so non uniform scaling and sheering doesn't skew it,
+// then carries on up through every group above the shape
+func NormalToWorld(s Shape, n *Vector) *Vector {
+	n = s.InverseTrans().TMulti(n)
+
+	// The inverse transpose will mess with w if there is any translation
+	n.W = 0
+	n = n.Norm()
+
+	if s.Parent() != nil {
+		n = NormalToWorld(s.Parent(), n)
+	}
+
+	return n
+}
+
+// CastsShadow checks the shape and every group above it, a shape only
+// casts a shadow if none of them have opted out
+func CastsShadow(s Shape) bool {
+	for ; s != nil; s = s.Parent() {
+		if !s.CastsShadow() {
+			return false
+		}
+	}
+	return true
+}
+
+// BaseShape holds the state common to every shape. The inverse and
+// inverse transpose of the transform are cached when it is set, as
+// inverting a Matrix4 by cofactors is far too slow to do per ray.
+//
+// Transforms are modified in place by Translate, Scale etc. so always
+// call SetTransform after changing one, or the cache will be stale.
+type BaseShape struct {
+	transform    *Transform
+	inverse      *Transform
+	inverseTrans *Transform
+	material     *Material
+	parent       Shape
+	castsShadow  bool
+}
+
+func NewBaseShape() BaseShape {
+	b := BaseShape{
+		material:    NewMaterial(),
+		castsShadow: true,
+	}
+	b.SetTransform(NewTransform())
+	return b
+}
+
+func (b *BaseShape) Transform() *Transform {
+	return b.transform
+}
+
+func (b *BaseShape) SetTransform(t *Transform) {
+	b.transform = t
+	b.inverse = t.Invert().(*Transform)
+	b.inverseTrans = b.inverse.Trans().(*Transform)
+}
+
+func (b *BaseShape) Inverse() *Transform {
+	return b.inverse
+}
+
+func (b *BaseShape) InverseTrans() *Transform {
+	return b.inverseTrans
+}
+
+func (b *BaseShape) Material() *Material {
+	return b.material
+}
+
+func (b *BaseShape) SetMaterial(m *Material) {
+	b.material = m
+}
+
+func (b *BaseShape) Parent() Shape {
+	return b.parent
+}
+
+func (b *BaseShape) SetParent(p Shape) {
+	b.parent = p
+}
+
+func (b *BaseShape) CastsShadow() bool {
+	return b.castsShadow
+}
+
+// SetCastsShadow lets a shape opt out of blocking light for shadow rays
+func (b *BaseShape) SetCastsShadow(c bool) {
+	b.castsShadow = c
+}
@@ -0,0 +1,212 @@
+package rt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testShape is a minimal shape that records the last object space ray
+// it was asked to intersect
+type testShape struct {
+	BaseShape
+	savedRay *Ray
+}
+
+func newTestShape() *testShape {
+	return &testShape{
+		BaseShape: NewBaseShape(),
+	}
+}
+
+func (s *testShape) LocalIntersect(r *Ray) Intersections {
+	s.savedRay = r
+	return Intersections{}
+}
+
+func (s *testShape) LocalNormalAt(p *Point, hit *Intersection) *Vector {
+	return NewVector(p.X, p.Y, p.Z)
+}
+
+// Scenario: The default transformation
+// Given s ← test_shape()
+// Then s.transform = identity_matrix
+func TestShapeDefaultTransform(t *testing.T) {
+	s := newTestShape()
+
+	assert.True(t, s.Transform().Equal(m4i))
+	assert.True(t, s.Inverse().Equal(m4i))
+	assert.True(t, s.InverseTrans().Equal(m4i))
+}
+
+// Scenario: Assigning a transformation
+// Given s ← test_shape()
+// When set_transform(s, translation(2, 3, 4))
+// Then s.transform = translation(2, 3, 4)
+func TestShapeSetTransform(t *testing.T) {
+	s := newTestShape()
+	m := NewTransform().Translate(2, 3, 4)
+
+	s.SetTransform(m)
+
+	assert.Same(t, m, s.Transform())
+	assert.True(t, s.Inverse().Equal(m.Invert()))
+	assert.True(t, s.InverseTrans().Equal(m.Invert().Trans()))
+}
+
+// Scenario: The default material
+// Given s ← test_shape()
+// When m ← s.material
+// Then m = material()
+func TestShapeDefaultMaterial(t *testing.T) {
+	s := newTestShape()
+
+	assert.Equal(t, NewMaterial(), s.Material())
+}
+
+// Scenario: Assigning a material
+// Given s ← test_shape()
+// And m ← material()
+// And m.ambient ← 1
+// When s.material ← m
+// Then s.material = m
+func TestShapeSetMaterial(t *testing.T) {
+	s := newTestShape()
+	m := NewMaterial()
+	m.Ambient = 1
+
+	s.SetMaterial(m)
+
+	assert.Same(t, m, s.Material())
+}
+
+// Scenario: A shape has a parent attribute
+// Given s ← test_shape()
+// Then s.parent is nothing
+func TestShapeParent(t *testing.T) {
+	s := newTestShape()
+	p := newTestShape()
+
+	assert.Nil(t, s.Parent())
+
+	s.SetParent(p)
+	assert.Same(t, p, s.Parent())
+}
+
+// Scenario: Intersecting a scaled shape with a ray
+// Given r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And s ← test_shape()
+// When set_transform(s, scaling(2, 2, 2))
+// And xs ← intersect(s, r)
+// Then s.saved_ray.origin = point(0, 0, -2.5)
+// And s.saved_ray.direction = vector(0, 0, 0.5)
+func TestShapeIntersectScaled(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	s := newTestShape()
+	s.SetTransform(NewTransform().Scale(2, 2, 2))
+
+	Intersect(s, r)
+
+	assert.True(t, NewPoint(0, 0, -2.5).Equals(s.savedRay.Origin))
+	assert.True(t, NewVector(0, 0, 0.5).Equals(s.savedRay.Direction))
+}
+
+// Scenario: Intersecting a translated shape with a ray
+// Given r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And s ← test_shape()
+// When set_transform(s, translation(5, 0, 0))
+// And xs ← intersect(s, r)
+// Then s.saved_ray.origin = point(-5, 0, -5)
+// And s.saved_ray.direction = vector(0, 0, 1)
+func TestShapeIntersectTranslated(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	s := newTestShape()
+	s.SetTransform(NewTransform().Translate(5, 0, 0))
+
+	Intersect(s, r)
+
+	assert.True(t, NewPoint(-5, 0, -5).Equals(s.savedRay.Origin))
+	assert.True(t, NewVector(0, 0, 1).Equals(s.savedRay.Direction))
+}
+
+// Scenario: Computing the normal on a translated shape
+// Given s ← test_shape()
+// When set_transform(s, translation(0, 1, 0))
+// And n ← normal_at(s, point(0, 1.70711, -0.70711))
+// Then n = vector(0, 0.70711, -0.70711)
+func TestShapeNormalTranslated(t *testing.T) {
+	s := newTestShape()
+	s.SetTransform(NewTransform().Translate(0, 1, 0))
+
+	n := NormalAt(s, NewPoint(0, 1.70711, -0.70711), nil)
+
+	assert.InDelta(t, 0, n.X, 0.00001)
+	assert.InDelta(t, 0.70711, n.Y, 0.00001)
+	assert.InDelta(t, -0.70711, n.Z, 0.00001)
+	assert.True(t, n.IsVector())
+}
+
+// Scenario: Computing the normal on a transformed shape
+// Given s ← test_shape()
+// And m ← scaling(1, 0.5, 1) * rotation_z(π/5)
+// When set_transform(s, m)
+// And n ← normal_at(s, point(0, √2/2, -√2/2))
+// Then n = vector(0, 0.97014, -0.24254)
+func TestShapeNormalTransformed(t *testing.T) {
+	s := newTestShape()
+	s.SetTransform(NewTransform().Scale(1, 0.5, 1).RotateZ(math.Pi / 5))
+
+	n := NormalAt(s, NewPoint(0, math.Sqrt2/2, -math.Sqrt2/2), nil)
+
+	assert.InDelta(t, 0, n.X, 0.00001)
+	assert.InDelta(t, 0.97014, n.Y, 0.00001)
+	assert.InDelta(t, -0.24254, n.Z, 0.00001)
+	assert.True(t, n.IsVector())
+}
+
+// Scenario: A sheered shape still has a normal perpendicular to its surface
+// Given s ← test_shape()
+// When set_transform(s, shearing(1, 0, 0, 0, 0, 0))
+// And n ← normal_at(s, point(1, 1, 0))
+// Then n is perpendicular to the sheered tangent vector(1, 0, 0)
+func TestShapeNormalSheered(t *testing.T) {
+	s := newTestShape()
+	s.SetTransform(NewTransform().Sheer(1, 0, 0, 0, 0, 0))
+
+	n := NormalAt(s, NewPoint(1, 1, 0), nil)
+	tangent := s.Transform().TMulti(NewVector(1, 0, 0))
+
+	assert.True(t, Equal(0, n.Dot(tangent)))
+	assert.True(t, Equal(1, n.Mag()))
+}
+
+// Shapes cast shadows unless told otherwise
+func TestShapeCastsShadow(t *testing.T) {
+	s := newTestShape()
+
+	assert.True(t, s.CastsShadow())
+
+	s.SetCastsShadow(false)
+	assert.False(t, s.CastsShadow())
+}
+
+// A shape casts a shadow only if it and every group above it do
+func TestShapeCastsShadowParents(t *testing.T) {
+	outer := NewGroup()
+	inner := NewGroup()
+	s := newTestShape()
+	outer.AddChild(inner)
+	inner.AddChild(s)
+
+	assert.True(t, CastsShadow(s))
+
+	outer.SetCastsShadow(false)
+	assert.False(t, CastsShadow(s))
+	assert.False(t, CastsShadow(inner))
+
+	outer.SetCastsShadow(true)
+	s.SetCastsShadow(false)
+	assert.False(t, CastsShadow(s))
+	assert.True(t, CastsShadow(inner))
+}
@@ -0,0 +1,50 @@
+package rt
+
+// SmoothTriangle is a triangle with a normal at each vertex, the normal at
+// any point on it is interpolated from them using the hit's u and v
+type SmoothTriangle struct {
+	BaseShape
+	P1, P2, P3 *Point
+	N1, N2, N3 *Vector
+	E1, E2     *Vector
+}
+
+func NewSmoothTriangle(p1, p2, p3 *Point, n1, n2, n3 *Vector) *SmoothTriangle {
+	tri := SmoothTriangle{
+		BaseShape: NewBaseShape(),
+		P1:        p1,
+		P2:        p2,
+		P3:        p3,
+		N1:        n1,
+		N2:        n2,
+		N3:        n3,
+	}
+
+	tri.E1 = p2.Sub(p1)
+	tri.E1.W = 0
+	tri.E2 = p3.Sub(p1)
+	tri.E2.W = 0
+
+	return &tri
+}
+
+func (tri *SmoothTriangle) LocalIntersect(r *Ray) Intersections {
+	t, u, v, hit := intersectTriangle(r, tri.P1, tri.E1, tri.E2)
+	if !hit {
+		return Intersections{}
+	}
+	return NewIntersections(NewIntersectionWithUV(t, tri, u, v))
+}
+
+// LocalNormalAt interpolates the vertex normals at the hit's u and v. Without
+// a hit there is nothing to interpolate from, so the flat normal is used.
+func (tri *SmoothTriangle) LocalNormalAt(p *Point, hit *Intersection) *Vector {
+	if hit == nil {
+		return tri.E2.Cross(tri.E1).Norm()
+	}
+
+	n := tri.N2.Multi(hit.U)
+	n = n.Add(tri.N3.Multi(hit.V))
+	n = n.Add(tri.N1.Multi(1 - hit.U - hit.V))
+	return n
+}
@@ -0,0 +1,98 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Background:
+// Given p1 ← point(0, 1, 0)
+// And p2 ← point(-1, 0, 0)
+// And p3 ← point(1, 0, 0)
+// And n1 ← vector(0, 1, 0)
+// And n2 ← vector(-1, 0, 0)
+// And n3 ← vector(1, 0, 0)
+// When tri ← smooth_triangle(p1, p2, p3, n1, n2, n3)
+func newTestSmoothTriangle() *SmoothTriangle {
+	return NewSmoothTriangle(
+		NewPoint(0, 1, 0), NewPoint(-1, 0, 0), NewPoint(1, 0, 0),
+		NewVector(0, 1, 0), NewVector(-1, 0, 0), NewVector(1, 0, 0),
+	)
+}
+
+// Scenario: Constructing a smooth triangle
+// Then tri.p1 = p1
+// And tri.p2 = p2
+// And tri.p3 = p3
+// And tri.n1 = n1
+// And tri.n2 = n2
+// And tri.n3 = n3
+func TestSmoothTriangleNew(t *testing.T) {
+	tri := newTestSmoothTriangle()
+
+	assert.True(t, NewPoint(0, 1, 0).Equals(tri.P1))
+	assert.True(t, NewPoint(-1, 0, 0).Equals(tri.P2))
+	assert.True(t, NewPoint(1, 0, 0).Equals(tri.P3))
+	assert.True(t, NewVector(0, 1, 0).Equals(tri.N1))
+	assert.True(t, NewVector(-1, 0, 0).Equals(tri.N2))
+	assert.True(t, NewVector(1, 0, 0).Equals(tri.N3))
+}
+
+// Scenario: An intersection with a smooth triangle stores u/v
+// When r ← ray(point(-0.2, 0.3, -2), vector(0, 0, 1))
+// And xs ← local_intersect(tri, r)
+// Then xs[0].u = 0.45
+// And xs[0].v = 0.25
+func TestSmoothTriangleIntersectUV(t *testing.T) {
+	tri := newTestSmoothTriangle()
+
+	xs := tri.LocalIntersect(NewRay(NewPoint(-0.2, 0.3, -2), NewVector(0, 0, 1)))
+
+	assert.Len(t, xs, 1)
+	assert.InDelta(t, 0.45, xs[0].U, 0.00001)
+	assert.InDelta(t, 0.25, xs[0].V, 0.00001)
+}
+
+// Scenario: A smooth triangle uses u/v to interpolate the normal
+// When i ← intersection_with_uv(1, tri, 0.45, 0.25)
+// And n ← normal_at(tri, point(0, 0, 0), i)
+// Then n = vector(-0.5547, 0.83205, 0)
+func TestSmoothTriangleNormal(t *testing.T) {
+	tri := newTestSmoothTriangle()
+	i := NewIntersectionWithUV(1, tri, 0.45, 0.25)
+
+	n := NormalAt(tri, NewPoint(0, 0, 0), i)
+
+	assert.InDelta(t, -0.5547, n.X, 0.0001)
+	assert.InDelta(t, 0.83205, n.Y, 0.0001)
+	assert.InDelta(t, 0, n.Z, 0.0001)
+}
+
+// Scenario: Preparing the normal on a smooth triangle
+// When i ← intersection_with_uv(1, tri, 0.45, 0.25)
+// And r ← ray(point(-0.2, 0.3, -2), vector(0, 0, 1))
+// And xs ← intersections(i)
+// And comps ← prepare_computations(i, r, xs)
+// Then comps.normalv = vector(-0.5547, 0.83205, 0)
+func TestSmoothTriangleComputations(t *testing.T) {
+	tri := newTestSmoothTriangle()
+	i := NewIntersectionWithUV(1, tri, 0.45, 0.25)
+	r := NewRay(NewPoint(-0.2, 0.3, -2), NewVector(0, 0, 1))
+	xs := NewIntersections(i)
+
+	c := PrepareComputations(i, r, xs)
+
+	assert.InDelta(t, -0.5547, c.NormalV.X, 0.0001)
+	assert.InDelta(t, 0.83205, c.NormalV.Y, 0.0001)
+	assert.InDelta(t, 0, c.NormalV.Z, 0.0001)
+}
+
+// Without a hit to interpolate from, the flat normal of the triangle is used
+func TestSmoothTriangleNormalNoHit(t *testing.T) {
+	tri := newTestSmoothTriangle()
+
+	n := NormalAt(tri, NewPoint(0, 0.5, 0), nil)
+
+	assert.True(t, NewVector(0, 0, -1).Equals(n))
+}
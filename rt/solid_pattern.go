@@ -0,0 +1,19 @@
+package rt
+
+// SolidPattern is a single color everywhere, it lets a plain color be used
+// wherever a pattern is expected
+type SolidPattern struct {
+	BasePattern
+	Color *Color
+}
+
+func NewSolidPattern(c *Color) *SolidPattern {
+	return &SolidPattern{
+		BasePattern: NewBasePattern(),
+		Color:       c,
+	}
+}
+
+func (s *SolidPattern) LocalPatternAt(p *Point) *Color {
+	return s.Color
+}
@@ -0,0 +1,17 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A solid pattern is the same color everywhere, whatever its transform
+func TestSolidPattern(t *testing.T) {
+	c := NewColor(0.2, 0.4, 0.6, 1)
+	p := NewSolidPattern(c)
+	p.SetTransform(NewTransform().Scale(2, 2, 2))
+
+	assert.Same(t, c, PatternAt(p, NewPoint(0, 0, 0)))
+	assert.Same(t, c, PatternAt(p, NewPoint(-3.5, 10, 0.25)))
+}
@@ -0,0 +1,42 @@
+package rt
+
+import "math"
+
+// Sphere is a unit sphere centered on the origin in object space
+type Sphere struct {
+	BaseShape
+}
+
+func NewSphere() *Sphere {
+	return &Sphere{
+		BaseShape: NewBaseShape(),
+	}
+}
+
+func (s *Sphere) LocalIntersect(r *Ray) Intersections {
+
+	// Vector from the sphere's center (the origin) to the ray origin
+	sr := NewVector(r.Origin.X, r.Origin.Y, r.Origin.Z)
+
+	a := r.Direction.Dot(r.Direction)
+	b := 2 * r.Direction.Dot(sr)
+	c := sr.Dot(sr) - 1
+
+	disc := b*b - 4*a*c
+
+	if disc < 0 {
+		return Intersections{}
+	}
+
+	t1 := (-b - math.Sqrt(disc)) / (2 * a)
+	t2 := (-b + math.Sqrt(disc)) / (2 * a)
+
+	return NewIntersections(
+		NewIntersection(t1, s),
+		NewIntersection(t2, s),
+	)
+}
+
+func (s *Sphere) LocalNormalAt(p *Point, hit *Intersection) *Vector {
+	return NewVector(p.X, p.Y, p.Z)
+}
@@ -0,0 +1,210 @@
+package rt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: A ray intersects a sphere at two points
+// Given r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And s ← sphere()
+// When xs ← intersect(s, r)
+// Then xs.count = 2
+// And xs[0] = 4.0
+// And xs[1] = 6.0
+func TestSphereIntersectTwoPoints(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	s := NewSphere()
+
+	xs := Intersect(s, r)
+
+	assert.Len(t, xs, 2)
+	assert.Equal(t, 4.0, xs[0].T)
+	assert.Equal(t, 6.0, xs[1].T)
+}
+
+// Scenario: A ray intersects a sphere at a tangent
+// Given r ← ray(point(0, 1, -5), vector(0, 0, 1))
+// And s ← sphere()
+// When xs ← intersect(s, r)
+// Then xs.count = 2
+// And xs[0] = 5.0
+// And xs[1] = 5.0
+func TestSphereIntersectTangent(t *testing.T) {
+	r := NewRay(NewPoint(0, 1, -5), NewVector(0, 0, 1))
+	s := NewSphere()
+
+	xs := Intersect(s, r)
+
+	assert.Len(t, xs, 2)
+	assert.Equal(t, 5.0, xs[0].T)
+	assert.Equal(t, 5.0, xs[1].T)
+}
+
+// Scenario: A ray misses a sphere
+// Given r ← ray(point(0, 2, -5), vector(0, 0, 1))
+// And s ← sphere()
+// When xs ← intersect(s, r)
+// Then xs.count = 0
+func TestSphereIntersectMiss(t *testing.T) {
+	r := NewRay(NewPoint(0, 2, -5), NewVector(0, 0, 1))
+	s := NewSphere()
+
+	xs := Intersect(s, r)
+
+	assert.Len(t, xs, 0)
+}
+
+// Scenario: A ray originates inside a sphere
+// Given r ← ray(point(0, 0, 0), vector(0, 0, 1))
+// And s ← sphere()
+// When xs ← intersect(s, r)
+// Then xs.count = 2
+// And xs[0] = -1.0
+// And xs[1] = 1.0
+func TestSphereIntersectInside(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, 0), NewVector(0, 0, 1))
+	s := NewSphere()
+
+	xs := Intersect(s, r)
+
+	assert.Len(t, xs, 2)
+	assert.Equal(t, -1.0, xs[0].T)
+	assert.Equal(t, 1.0, xs[1].T)
+}
+
+// Scenario: A sphere is behind a ray
+// Given r ← ray(point(0, 0, 5), vector(0, 0, 1))
+// And s ← sphere()
+// When xs ← intersect(s, r)
+// Then xs.count = 2
+// And xs[0] = -6.0
+// And xs[1] = -4.0
+func TestSphereIntersectBehind(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, 5), NewVector(0, 0, 1))
+	s := NewSphere()
+
+	xs := Intersect(s, r)
+
+	assert.Len(t, xs, 2)
+	assert.Equal(t, -6.0, xs[0].T)
+	assert.Equal(t, -4.0, xs[1].T)
+}
+
+// Scenario: Intersect sets the object on the intersection
+// Given r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And s ← sphere()
+// When xs ← intersect(s, r)
+// Then xs.count = 2
+// And xs[0].object = s
+// And xs[1].object = s
+func TestSphereIntersectSetsObject(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	s := NewSphere()
+
+	xs := Intersect(s, r)
+
+	assert.Len(t, xs, 2)
+	assert.Same(t, s, xs[0].Object)
+	assert.Same(t, s, xs[1].Object)
+}
+
+// Scenario: Intersecting a scaled sphere with a ray
+// Given r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And s ← sphere()
+// When set_transform(s, scaling(2, 2, 2))
+// And xs ← intersect(s, r)
+// Then xs.count = 2
+// And xs[0].t = 3
+// And xs[1].t = 7
+func TestSphereIntersectScaled(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	s := NewSphere()
+	s.SetTransform(NewTransform().Scale(2, 2, 2))
+
+	xs := Intersect(s, r)
+
+	assert.Len(t, xs, 2)
+	assert.True(t, Equal(3, xs[0].T))
+	assert.True(t, Equal(7, xs[1].T))
+}
+
+// Scenario: Intersecting a translated sphere with a ray
+// Given r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And s ← sphere()
+// When set_transform(s, translation(5, 0, 0))
+// And xs ← intersect(s, r)
+// Then xs.count = 0
+func TestSphereIntersectTranslated(t *testing.T) {
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	s := NewSphere()
+	s.SetTransform(NewTransform().Translate(5, 0, 0))
+
+	xs := Intersect(s, r)
+
+	assert.Len(t, xs, 0)
+}
+
+// Scenario: The normal on a sphere at a point on the x axis
+// Given s ← sphere()
+// When n ← normal_at(s, point(1, 0, 0))
+// Then n = vector(1, 0, 0)
+
+// Scenario: The normal on a sphere at a point on the y axis
+// Given s ← sphere()
+// When n ← normal_at(s, point(0, 1, 0))
+// Then n = vector(0, 1, 0)
+
+// Scenario: The normal on a sphere at a point on the z axis
+// Given s ← sphere()
+// When n ← normal_at(s, point(0, 0, 1))
+// Then n = vector(0, 0, 1)
+func TestSphereNormalAxis(t *testing.T) {
+	s := NewSphere()
+
+	assert.True(t, NewVector(1, 0, 0).Equals(NormalAt(s, NewPoint(1, 0, 0), nil)))
+	assert.True(t, NewVector(0, 1, 0).Equals(NormalAt(s, NewPoint(0, 1, 0), nil)))
+	assert.True(t, NewVector(0, 0, 1).Equals(NormalAt(s, NewPoint(0, 0, 1), nil)))
+}
+
+// Scenario: The normal on a sphere at a nonaxial point
+// Given s ← sphere()
+// When n ← normal_at(s, point(√3/3, √3/3, √3/3))
+// Then n = vector(√3/3, √3/3, √3/3)
+
+// Scenario: The normal is a normalized vector
+// Given s ← sphere()
+// When n ← normal_at(s, point(√3/3, √3/3, √3/3))
+// Then n = normalize(n)
+func TestSphereNormalNonAxial(t *testing.T) {
+	s := NewSphere()
+	v := math.Sqrt(3) / 3
+
+	n := NormalAt(s, NewPoint(v, v, v), nil)
+
+	assert.True(t, NewVector(v, v, v).Equals(n))
+	assert.True(t, n.Norm().Equals(n))
+}
+
+// newGlassSphere is a sphere made of fully transparent glass
+func newGlassSphere() *Sphere {
+	s := NewSphere()
+	s.Material().Transparency = 1.0
+	s.Material().RefractiveIndex = 1.5
+	return s
+}
+
+// Scenario: A helper for producing a sphere with a glassy material
+// Given s ← glass_sphere()
+// Then s.transform = identity_matrix
+// And s.material.transparency = 1.0
+// And s.material.refractive_index = 1.5
+func TestSphereGlass(t *testing.T) {
+	s := newGlassSphere()
+
+	assert.True(t, s.Transform().Equal(NewTransform()))
+	assert.Equal(t, 1.0, s.Material().Transparency)
+	assert.Equal(t, 1.5, s.Material().RefractiveIndex)
+}
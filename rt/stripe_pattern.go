@@ -0,0 +1,30 @@
+package rt
+
+import "math"
+
+// StripePattern alternates between A and B every unit along x
+type StripePattern struct {
+	BasePattern
+	A Pattern
+	B Pattern
+}
+
+func NewStripePattern(a, b *Color) *StripePattern {
+	return NewNestedStripePattern(NewSolidPattern(a), NewSolidPattern(b))
+}
+
+// NewNestedStripePattern creates stripes that are filled with other patterns
+func NewNestedStripePattern(a, b Pattern) *StripePattern {
+	return &StripePattern{
+		BasePattern: NewBasePattern(),
+		A:           a,
+		B:           b,
+	}
+}
+
+func (s *StripePattern) LocalPatternAt(p *Point) *Color {
+	if int(math.Floor(p.X))%2 == 0 {
+		return PatternAt(s.A, p)
+	}
+	return PatternAt(s.B, p)
+}
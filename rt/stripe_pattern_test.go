@@ -0,0 +1,86 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	patternWhite = NewColor(1, 1, 1, 1)
+	patternBlack = NewColor(0, 0, 0, 1)
+)
+
+// Scenario: Creating a stripe pattern
+// Given pattern ← stripe_pattern(white, black)
+// Then pattern.a = white
+// And pattern.b = black
+func TestStripePatternNew(t *testing.T) {
+	p := NewStripePattern(patternWhite, patternBlack)
+
+	assert.Same(t, patternWhite, p.A.(*SolidPattern).Color)
+	assert.Same(t, patternBlack, p.B.(*SolidPattern).Color)
+}
+
+// Scenario: A stripe pattern is constant in y
+// Given pattern ← stripe_pattern(white, black)
+// Then stripe_at(pattern, point(0, 0, 0)) = white
+// And stripe_at(pattern, point(0, 1, 0)) = white
+// And stripe_at(pattern, point(0, 2, 0)) = white
+func TestStripePatternConstantY(t *testing.T) {
+	p := NewStripePattern(patternWhite, patternBlack)
+
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0, 0))))
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 1, 0))))
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 2, 0))))
+}
+
+// Scenario: A stripe pattern is constant in z
+// Given pattern ← stripe_pattern(white, black)
+// Then stripe_at(pattern, point(0, 0, 0)) = white
+// And stripe_at(pattern, point(0, 0, 1)) = white
+// And stripe_at(pattern, point(0, 0, 2)) = white
+func TestStripePatternConstantZ(t *testing.T) {
+	p := NewStripePattern(patternWhite, patternBlack)
+
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0, 0))))
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0, 1))))
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0, 2))))
+}
+
+// Scenario: A stripe pattern alternates in x
+// Given pattern ← stripe_pattern(white, black)
+// Then stripe_at(pattern, point(0, 0, 0)) = white
+// And stripe_at(pattern, point(0.9, 0, 0)) = white
+// And stripe_at(pattern, point(1, 0, 0)) = black
+// And stripe_at(pattern, point(-0.1, 0, 0)) = black
+// And stripe_at(pattern, point(-1, 0, 0)) = black
+// And stripe_at(pattern, point(-1.1, 0, 0)) = white
+func TestStripePatternAlternatesX(t *testing.T) {
+	p := NewStripePattern(patternWhite, patternBlack)
+
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0, 0, 0))))
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(0.9, 0, 0))))
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(1, 0, 0))))
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(-0.1, 0, 0))))
+	assert.True(t, patternBlack.Equals(p.LocalPatternAt(NewPoint(-1, 0, 0))))
+	assert.True(t, patternWhite.Equals(p.LocalPatternAt(NewPoint(-1.1, 0, 0))))
+}
+
+// Scenario: Stripes with both an object and a pattern transformation
+// Given object ← sphere()
+// And set_transform(object, scaling(2, 2, 2))
+// And pattern ← stripe_pattern(white, black)
+// And set_pattern_transform(pattern, translation(0.5, 0, 0))
+// When c ← stripe_at_object(pattern, object, point(2.5, 0, 0))
+// Then c = white
+func TestStripePatternTransforms(t *testing.T) {
+	s := NewSphere()
+	s.SetTransform(NewTransform().Scale(2, 2, 2))
+	p := NewStripePattern(patternWhite, patternBlack)
+	p.SetTransform(NewTransform().Translate(0.5, 0, 0))
+
+	c := PatternAtShape(p, s, NewPoint(2.5, 0, 0))
+
+	assert.True(t, patternWhite.Equals(c))
+}
@@ -75,3 +75,23 @@ func (t *Transform) RotateZ(rads float64) *Transform {
 	}))
 	return t
 }
+
+// ViewTransform orients the world relative to an eye at from, looking at to
+func ViewTransform(from *Point, to *Point, up *Vector) *Transform {
+	fwd := to.Sub(from)
+	fwd.W = 0
+	fwd = fwd.Norm()
+	left := fwd.Cross(up.Norm())
+	trueUp := left.Cross(fwd)
+
+	orientation := NewMatrix4([]float64{
+		left.X, left.Y, left.Z, 0,
+		trueUp.X, trueUp.Y, trueUp.Z, 0,
+		-fwd.X, -fwd.Y, -fwd.Z, 0,
+		0, 0, 0, 1,
+	})
+
+	orientation.IMulti(NewTransform().Translate(-from.X, -from.Y, -from.Z))
+
+	return orientation
+}
@@ -260,3 +260,62 @@ func TestTransformSheer(t *testing.T) {
 	pe6 := NewPoint(2, 3, 7)
 	assert.True(t, pr6.Equals(pe6))
 }
+
+// Scenario: The transformation matrix for the default orientation
+// Given from ← point(0, 0, 0)
+// And to ← point(0, 0, -1)
+// And up ← vector(0, 1, 0)
+// When t ← view_transform(from, to, up)
+// Then t = identity_matrix
+func TestViewTransformDefault(t *testing.T) {
+	vt := ViewTransform(NewPoint(0, 0, 0), NewPoint(0, 0, -1), NewVector(0, 1, 0))
+
+	assert.True(t, vt.Equal(m4i))
+}
+
+// Scenario: A view transformation matrix looking in positive z direction
+// Given from ← point(0, 0, 0)
+// And to ← point(0, 0, 1)
+// And up ← vector(0, 1, 0)
+// When t ← view_transform(from, to, up)
+// Then t = scaling(-1, 1, -1)
+func TestViewTransformPositiveZ(t *testing.T) {
+	vt := ViewTransform(NewPoint(0, 0, 0), NewPoint(0, 0, 1), NewVector(0, 1, 0))
+
+	assert.True(t, vt.Equal(NewTransform().Scale(-1, 1, -1)))
+}
+
+// Scenario: The view transformation moves the world
+// Given from ← point(0, 0, 8)
+// And to ← point(0, 0, 0)
+// And up ← vector(0, 1, 0)
+// When t ← view_transform(from, to, up)
+// Then t = translation(0, 0, -8)
+func TestViewTransformMovesWorld(t *testing.T) {
+	vt := ViewTransform(NewPoint(0, 0, 8), NewPoint(0, 0, 0), NewVector(0, 1, 0))
+
+	assert.True(t, vt.Equal(NewTransform().Translate(0, 0, -8)))
+}
+
+// Scenario: An arbitrary view transformation
+// Given from ← point(1, 3, 2)
+// And to ← point(4, -2, 8)
+// And up ← vector(1, 1, 0)
+// When t ← view_transform(from, to, up)
+// Then t is the following 4x4 matrix:
+// | -0.50709 | 0.50709 | 0.67612 | -2.36643 |
+// | 0.76772 | 0.60609 | 0.12122 | -2.82843 |
+// | -0.35857 | 0.59761 | -0.71714 | 0.00000 |
+// | 0.00000 | 0.00000 | 0.00000 | 1.00000 |
+func TestViewTransformArbitrary(t *testing.T) {
+	vt := ViewTransform(NewPoint(1, 3, 2), NewPoint(4, -2, 8), NewVector(1, 1, 0))
+
+	e := []float64{
+		-0.50709, 0.50709, 0.67612, -2.36643,
+		0.76772, 0.60609, 0.12122, -2.82843,
+		-0.35857, 0.59761, -0.71714, 0.00000,
+		0.00000, 0.00000, 0.00000, 1.00000,
+	}
+
+	assert.InDeltaSlice(t, e, vt.Data(), 0.00001)
+}
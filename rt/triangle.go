@@ -0,0 +1,74 @@
+package rt
+
+import "math"
+
+// Triangle is a flat triangle, the edges and normal are precomputed as
+// they are the same for every ray
+type Triangle struct {
+	BaseShape
+	P1, P2, P3 *Point
+	E1, E2     *Vector
+	Normal     *Vector
+}
+
+func NewTriangle(p1, p2, p3 *Point) *Triangle {
+	tri := Triangle{
+		BaseShape: NewBaseShape(),
+		P1:        p1,
+		P2:        p2,
+		P3:        p3,
+	}
+
+	// point - point is a point here, so fix w on the edges
+	tri.E1 = p2.Sub(p1)
+	tri.E1.W = 0
+	tri.E2 = p3.Sub(p1)
+	tri.E2.W = 0
+	tri.Normal = tri.E2.Cross(tri.E1).Norm()
+
+	return &tri
+}
+
+// intersectTriangle is the Möller–Trumbore algorithm, it returns the t of
+// the intersection and the barycentric u and v of where it hit
+func intersectTriangle(r *Ray, p1 *Point, e1, e2 *Vector) (t, u, v float64, hit bool) {
+	dirCrossE2 := r.Direction.Cross(e2)
+	det := e1.Dot(dirCrossE2)
+
+	// The ray is parallel to the triangle
+	if math.Abs(det) < SMALL_NUMBER_F64 {
+		return 0, 0, 0, false
+	}
+
+	f := 1.0 / det
+
+	p1ToOrigin := r.Origin.Sub(p1)
+	p1ToOrigin.W = 0
+
+	u = f * p1ToOrigin.Dot(dirCrossE2)
+	if u < 0 || u > 1 {
+		return 0, 0, 0, false
+	}
+
+	originCrossE1 := p1ToOrigin.Cross(e1)
+	v = f * r.Direction.Dot(originCrossE1)
+	if v < 0 || (u+v) > 1 {
+		return 0, 0, 0, false
+	}
+
+	t = f * e2.Dot(originCrossE1)
+
+	return t, u, v, true
+}
+
+func (tri *Triangle) LocalIntersect(r *Ray) Intersections {
+	t, u, v, hit := intersectTriangle(r, tri.P1, tri.E1, tri.E2)
+	if !hit {
+		return Intersections{}
+	}
+	return NewIntersections(NewIntersectionWithUV(t, tri, u, v))
+}
+
+func (tri *Triangle) LocalNormalAt(p *Point, hit *Intersection) *Vector {
+	return tri.Normal
+}
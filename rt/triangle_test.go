@@ -0,0 +1,107 @@
+package rt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario: Constructing a triangle
+// Given p1 ← point(0, 1, 0)
+// And p2 ← point(-1, 0, 0)
+// And p3 ← point(1, 0, 0)
+// And t ← triangle(p1, p2, p3)
+// Then t.p1 = p1
+// And t.p2 = p2
+// And t.p3 = p3
+// And t.e1 = vector(-1, -1, 0)
+// And t.e2 = vector(1, -1, 0)
+// And t.normal = vector(0, 0, -1)
+func TestTriangleNew(t *testing.T) {
+	p1 := NewPoint(0, 1, 0)
+	p2 := NewPoint(-1, 0, 0)
+	p3 := NewPoint(1, 0, 0)
+
+	tri := NewTriangle(p1, p2, p3)
+
+	assert.True(t, p1.Equals(tri.P1))
+	assert.True(t, p2.Equals(tri.P2))
+	assert.True(t, p3.Equals(tri.P3))
+	assert.True(t, NewVector(-1, -1, 0).Equals(tri.E1))
+	assert.True(t, NewVector(1, -1, 0).Equals(tri.E2))
+	assert.True(t, NewVector(0, 0, -1).Equals(tri.Normal))
+}
+
+// Scenario: Finding the normal on a triangle
+// Given t ← triangle(point(0, 1, 0), point(-1, 0, 0), point(1, 0, 0))
+// When n1 ← local_normal_at(t, point(0, 0.5, 0))
+// And n2 ← local_normal_at(t, point(-0.5, 0.75, 0))
+// And n3 ← local_normal_at(t, point(0.5, 0.25, 0))
+// Then n1 = t.normal
+// And n2 = t.normal
+// And n3 = t.normal
+func TestTriangleNormal(t *testing.T) {
+	tri := NewTriangle(NewPoint(0, 1, 0), NewPoint(-1, 0, 0), NewPoint(1, 0, 0))
+
+	assert.True(t, tri.Normal.Equals(tri.LocalNormalAt(NewPoint(0, 0.5, 0), nil)))
+	assert.True(t, tri.Normal.Equals(tri.LocalNormalAt(NewPoint(-0.5, 0.75, 0), nil)))
+	assert.True(t, tri.Normal.Equals(tri.LocalNormalAt(NewPoint(0.5, 0.25, 0), nil)))
+}
+
+// Scenario: Intersecting a ray parallel to the triangle
+// Given t ← triangle(point(0, 1, 0), point(-1, 0, 0), point(1, 0, 0))
+// And r ← ray(point(0, -1, -2), vector(0, 1, 0))
+// When xs ← local_intersect(t, r)
+// Then xs is empty
+func TestTriangleIntersectParallel(t *testing.T) {
+	tri := NewTriangle(NewPoint(0, 1, 0), NewPoint(-1, 0, 0), NewPoint(1, 0, 0))
+
+	xs := tri.LocalIntersect(NewRay(NewPoint(0, -1, -2), NewVector(0, 1, 0)))
+
+	assert.Len(t, xs, 0)
+}
+
+// Scenario: A ray misses the p1-p3 edge
+// Given t ← triangle(point(0, 1, 0), point(-1, 0, 0), point(1, 0, 0))
+// And r ← ray(point(1, 1, -2), vector(0, 0, 1))
+// When xs ← local_intersect(t, r)
+// Then xs is empty
+
+// Scenario: A ray misses the p1-p2 edge
+// Given t ← triangle(point(0, 1, 0), point(-1, 0, 0), point(1, 0, 0))
+// And r ← ray(point(-1, 1, -2), vector(0, 0, 1))
+// When xs ← local_intersect(t, r)
+// Then xs is empty
+
+// Scenario: A ray misses the p2-p3 edge
+// Given t ← triangle(point(0, 1, 0), point(-1, 0, 0), point(1, 0, 0))
+// And r ← ray(point(0, -1, -2), vector(0, 0, 1))
+// When xs ← local_intersect(t, r)
+// Then xs is empty
+func TestTriangleIntersectMissEdges(t *testing.T) {
+	tri := NewTriangle(NewPoint(0, 1, 0), NewPoint(-1, 0, 0), NewPoint(1, 0, 0))
+
+	xs1 := tri.LocalIntersect(NewRay(NewPoint(1, 1, -2), NewVector(0, 0, 1)))
+	xs2 := tri.LocalIntersect(NewRay(NewPoint(-1, 1, -2), NewVector(0, 0, 1)))
+	xs3 := tri.LocalIntersect(NewRay(NewPoint(0, -1, -2), NewVector(0, 0, 1)))
+
+	assert.Len(t, xs1, 0)
+	assert.Len(t, xs2, 0)
+	assert.Len(t, xs3, 0)
+}
+
+// Scenario: A ray strikes a triangle
+// Given t ← triangle(point(0, 1, 0), point(-1, 0, 0), point(1, 0, 0))
+// And r ← ray(point(0, 0.5, -2), vector(0, 0, 1))
+// When xs ← local_intersect(t, r)
+// Then xs.count = 1
+// And xs[0].t = 2
+func TestTriangleIntersect(t *testing.T) {
+	tri := NewTriangle(NewPoint(0, 1, 0), NewPoint(-1, 0, 0), NewPoint(1, 0, 0))
+
+	xs := tri.LocalIntersect(NewRay(NewPoint(0, 0.5, -2), NewVector(0, 0, 1)))
+
+	assert.Len(t, xs, 1)
+	assert.True(t, Equal(2, xs[0].T))
+	assert.Same(t, tri, xs[0].Object)
+}
@@ -105,6 +105,11 @@ func (t *Vector) Cross(t2 *Vector) *Vector {
 	}
 }
 
+// Reflect the vector around the normal n
+func (t *Vector) Reflect(n *Vector) *Vector {
+	return t.Sub(n.Multi(2 * t.Dot(n)))
+}
+
 // hadamard product for colors
 func (c *Color) Prod(c2 *Color) Color {
 	return Color{
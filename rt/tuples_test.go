@@ -346,6 +346,34 @@ func TestVectorCross(t *testing.T) {
 	assert.True(t, r2.Equals(e2), "Cross Product is incorrect")
 }
 
+// Scenario: Reflecting a vector approaching at 45°
+// Given v ← vector(1, -1, 0)
+// And n ← vector(0, 1, 0)
+// When r ← reflect(v, n)
+// Then r = vector(1, 1, 0)
+func TestVectorReflect45(t *testing.T) {
+	v := NewVector(1, -1, 0)
+	n := NewVector(0, 1, 0)
+
+	r := v.Reflect(n)
+
+	assert.True(t, NewVector(1, 1, 0).Equals(r), "Reflection is incorrect")
+}
+
+// Scenario: Reflecting a vector off a slanted surface
+// Given v ← vector(0, -1, 0)
+// And n ← vector(√2/2, √2/2, 0)
+// When r ← reflect(v, n)
+// Then r = vector(1, 0, 0)
+func TestVectorReflectSlanted(t *testing.T) {
+	v := NewVector(0, -1, 0)
+	n := NewVector(math.Sqrt2/2, math.Sqrt2/2, 0)
+
+	r := v.Reflect(n)
+
+	assert.True(t, NewVector(1, 0, 0).Equals(r), "Reflection is incorrect")
+}
+
 // Scenario: Colors are (red, green, blue) tuples Given c ← color(-0.5, 0.4, 1.7)
 // Then c.red = -0.5
 // And c.green = 0.4 And c.blue = 1.7
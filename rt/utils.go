@@ -20,7 +20,12 @@ func Equal(a float64, b float64) bool {
 
 // Convert float 64 To int, clamping between 0 and 255 and rounding up
 func F64ToStr_RGB255(f float64) string {
-	return strconv.Itoa(int(Clamp((math.Ceil(f * 255)), 0, 255)))
+	return strconv.Itoa(F64ToInt_RGB255(f))
+}
+
+// Convert float 64 To int, clamping between 0 and 255 and rounding up
+func F64ToInt_RGB255(f float64) int {
+	return int(Clamp((math.Ceil(f * 255)), 0, 255))
 }
 
 func Clamp(v, lo, hi float64) float64 {
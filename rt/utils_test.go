@@ -21,6 +21,14 @@ func TestF64ToStr_RGB255t(t *testing.T) {
 	assert.Equal(t, "0", r5, "Failed to convert float to 8 bit int string")
 }
 
+func TestF64ToInt_RGB255(t *testing.T) {
+	assert.Equal(t, 255, F64ToInt_RGB255(1))
+	assert.Equal(t, 128, F64ToInt_RGB255(0.5))
+	assert.Equal(t, 0, F64ToInt_RGB255(0))
+	assert.Equal(t, 255, F64ToInt_RGB255(1.5))
+	assert.Equal(t, 0, F64ToInt_RGB255(-1.5))
+}
+
 func TestWriteFile(t *testing.T) {
 	t.Skip()
 	content := "This is a file"
@@ -0,0 +1,120 @@
+package rt
+
+import "math"
+
+// UVMapping flattens a 3D point on a surface into u and v coordinates,
+// each from 0 to 1, for looking up a color in a UVPattern
+type UVMapping func(p *Point) (u, v float64)
+
+// SphericalMap wraps u around a sphere's equator and runs v from the
+// south pole to the north pole
+func SphericalMap(p *Point) (u, v float64) {
+	// Azimuthal angle, -π to π around the y axis
+	theta := math.Atan2(p.X, p.Z)
+
+	// Polar angle, 0 to π from the north pole
+	radius := math.Sqrt(p.X*p.X + p.Y*p.Y + p.Z*p.Z)
+	phi := math.Acos(p.Y / radius)
+
+	// Flip u so it increases counter clockwise when viewed from above
+	rawU := theta / (2 * math.Pi)
+	u = 1 - (rawU + 0.5)
+	v = 1 - phi/math.Pi
+
+	return u, v
+}
+
+// PlanarMap repeats the texture every unit along x and z
+func PlanarMap(p *Point) (u, v float64) {
+	return p.X - math.Floor(p.X), p.Z - math.Floor(p.Z)
+}
+
+// CylindricalMap wraps u around the y axis and repeats v every unit along y
+func CylindricalMap(p *Point) (u, v float64) {
+	theta := math.Atan2(p.X, p.Z)
+	rawU := theta / (2 * math.Pi)
+	u = 1 - (rawU + 0.5)
+	v = p.Y - math.Floor(p.Y)
+
+	return u, v
+}
+
+// UVPattern is a 2D pattern that colors a surface through a UVMapping
+type UVPattern interface {
+	UVPatternAt(u, v float64) *Color
+}
+
+// UVCheckersPattern is a checker board Width squares across and Height
+// squares high
+type UVCheckersPattern struct {
+	Width  int
+	Height int
+	A      *Color
+	B      *Color
+}
+
+func NewUVCheckersPattern(width, height int, a, b *Color) *UVCheckersPattern {
+	return &UVCheckersPattern{
+		Width:  width,
+		Height: height,
+		A:      a,
+		B:      b,
+	}
+}
+
+func (c *UVCheckersPattern) UVPatternAt(u, v float64) *Color {
+	u2 := math.Floor(u * float64(c.Width))
+	v2 := math.Floor(v * float64(c.Height))
+
+	if int(u2+v2)%2 == 0 {
+		return c.A
+	}
+	return c.B
+}
+
+// UVImagePattern looks up the color of the nearest pixel in a canvas, with
+// v of 0 being the bottom of the image
+type UVImagePattern struct {
+	Canvas *Canvas
+}
+
+func NewUVImagePattern(c *Canvas) *UVImagePattern {
+	return &UVImagePattern{
+		Canvas: c,
+	}
+}
+
+func (i *UVImagePattern) UVPatternAt(u, v float64) *Color {
+	// Canvases count rows from the top, so flip v
+	v = 1 - v
+
+	x := int(math.Round(u * float64(i.Canvas.Width-1)))
+	y := int(math.Round(v * float64(i.Canvas.Height-1)))
+
+	c := i.Canvas.Get(x, y)
+	if c == nil {
+		return NewColor(0, 0, 0, 1)
+	}
+	return c
+}
+
+// TextureMapPattern colors a surface by mapping each point to u and v and
+// looking them up in a UVPattern
+type TextureMapPattern struct {
+	BasePattern
+	UVPattern UVPattern
+	Mapping   UVMapping
+}
+
+func NewTextureMapPattern(uv UVPattern, mapping UVMapping) *TextureMapPattern {
+	return &TextureMapPattern{
+		BasePattern: NewBasePattern(),
+		UVPattern:   uv,
+		Mapping:     mapping,
+	}
+}
+
+func (t *TextureMapPattern) LocalPatternAt(p *Point) *Color {
+	u, v := t.Mapping(p)
+	return t.UVPattern.UVPatternAt(u, v)
+}
@@ -0,0 +1,185 @@
+package rt
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Scenario Outline: Checker pattern in 2D
+// Given checkers ← uv_checkers(2, 2, black, white)
+// When color ← uv_pattern_at(checkers, <u>, <v>)
+// Then color = <expected>
+func TestUVCheckersPattern(t *testing.T) {
+	c := NewUVCheckersPattern(2, 2, patternBlack, patternWhite)
+
+	tests := []struct {
+		u, v     float64
+		expected *Color
+	}{
+		{0.0, 0.0, patternBlack},
+		{0.5, 0.0, patternWhite},
+		{0.0, 0.5, patternWhite},
+		{0.5, 0.5, patternBlack},
+		{1.0, 1.0, patternBlack},
+	}
+
+	for _, tt := range tests {
+		assert.Same(t, tt.expected, c.UVPatternAt(tt.u, tt.v), "u %v v %v", tt.u, tt.v)
+	}
+}
+
+// Scenario Outline: Using a spherical mapping on a 3D point
+// Given p ← <point>
+// When (u, v) ← spherical_map(p)
+// Then u = <u>
+// And v = <v>
+func TestSphericalMap(t *testing.T) {
+	tests := []struct {
+		p    *Point
+		u, v float64
+	}{
+		{NewPoint(0, 0, -1), 0.0, 0.5},
+		{NewPoint(1, 0, 0), 0.25, 0.5},
+		{NewPoint(0, 0, 1), 0.5, 0.5},
+		{NewPoint(-1, 0, 0), 0.75, 0.5},
+		{NewPoint(0, 1, 0), 0.5, 1.0},
+		{NewPoint(0, -1, 0), 0.5, 0.0},
+		{NewPoint(math.Sqrt2/2, math.Sqrt2/2, 0), 0.25, 0.75},
+	}
+
+	for _, tt := range tests {
+		u, v := SphericalMap(tt.p)
+		assert.InDelta(t, tt.u, u, SMALL_NUMBER_F64, "%v", tt.p)
+		assert.InDelta(t, tt.v, v, SMALL_NUMBER_F64, "%v", tt.p)
+	}
+}
+
+// Scenario Outline: Using a texture map pattern with a spherical map
+// Given checkers ← uv_checkers(16, 8, black, white)
+// And pattern ← texture_map(checkers, spherical_map)
+// Then pattern_at(pattern, <point>) = <color>
+func TestTextureMapSpherical(t *testing.T) {
+	p := NewTextureMapPattern(NewUVCheckersPattern(16, 8, patternBlack, patternWhite), SphericalMap)
+
+	tests := []struct {
+		p        *Point
+		expected *Color
+	}{
+		{NewPoint(0.4315, 0.4670, 0.7719), patternWhite},
+		{NewPoint(-0.9654, 0.2552, -0.0534), patternBlack},
+		{NewPoint(0.1039, 0.7090, 0.6975), patternWhite},
+		{NewPoint(-0.4986, -0.7856, -0.3663), patternBlack},
+		{NewPoint(-0.0317, -0.9395, 0.3411), patternBlack},
+		{NewPoint(0.4809, -0.7721, 0.4154), patternBlack},
+		{NewPoint(0.0285, -0.9612, -0.2745), patternBlack},
+		{NewPoint(-0.5734, -0.2162, -0.7903), patternWhite},
+		{NewPoint(0.7688, -0.1470, 0.6223), patternBlack},
+		{NewPoint(-0.7652, 0.2175, 0.6060), patternBlack},
+	}
+
+	for _, tt := range tests {
+		assert.Same(t, tt.expected, PatternAt(p, tt.p), "%v", tt.p)
+	}
+}
+
+// Scenario Outline: Using a planar mapping on a 3D point
+// Given p ← <point>
+// When (u, v) ← planar_map(p)
+// Then u = <u>
+// And v = <v>
+func TestPlanarMap(t *testing.T) {
+	tests := []struct {
+		p    *Point
+		u, v float64
+	}{
+		{NewPoint(0.25, 0, 0.5), 0.25, 0.5},
+		{NewPoint(0.25, 0, -0.25), 0.25, 0.75},
+		{NewPoint(0.25, 0.5, -0.25), 0.25, 0.75},
+		{NewPoint(1.25, 0, 0.5), 0.25, 0.5},
+		{NewPoint(0.25, 0, -1.75), 0.25, 0.25},
+		{NewPoint(1, 0, -1), 0.0, 0.0},
+		{NewPoint(0, 0, 0), 0.0, 0.0},
+	}
+
+	for _, tt := range tests {
+		u, v := PlanarMap(tt.p)
+		assert.InDelta(t, tt.u, u, SMALL_NUMBER_F64, "%v", tt.p)
+		assert.InDelta(t, tt.v, v, SMALL_NUMBER_F64, "%v", tt.p)
+	}
+}
+
+// Scenario Outline: Using a cylindrical mapping on a 3D point
+// Given p ← <point>
+// When (u, v) ← cylindrical_map(p)
+// Then u = <u>
+// And v = <v>
+func TestCylindricalMap(t *testing.T) {
+	tests := []struct {
+		p    *Point
+		u, v float64
+	}{
+		{NewPoint(0, 0, -1), 0.0, 0.0},
+		{NewPoint(0, 0.5, -1), 0.0, 0.5},
+		{NewPoint(0, 1, -1), 0.0, 0.0},
+		{NewPoint(0.70711, 0.5, -0.70711), 0.125, 0.5},
+		{NewPoint(1, 0.5, 0), 0.25, 0.5},
+		{NewPoint(0.70711, 0.5, 0.70711), 0.375, 0.5},
+		{NewPoint(0, -0.25, 1), 0.5, 0.75},
+		{NewPoint(-0.70711, 0.5, 0.70711), 0.625, 0.5},
+		{NewPoint(-1, 1.25, 0), 0.75, 0.25},
+		{NewPoint(-0.70711, 0.5, -0.70711), 0.875, 0.5},
+	}
+
+	for _, tt := range tests {
+		u, v := CylindricalMap(tt.p)
+		assert.InDelta(t, tt.u, u, 0.0001, "%v", tt.p)
+		assert.InDelta(t, tt.v, v, 0.0001, "%v", tt.p)
+	}
+}
+
+// Scenario Outline: uv_image() pattern
+// Given ppm ← a file containing:
+// """
+// P3
+// 10 10
+// 10
+// 0 0 0  1 1 1  2 2 2  3 3 3  4 4 4  5 5 5  6 6 6  7 7 7  8 8 8  9 9 9
+// 1 1 1  2 2 2  3 3 3  4 4 4  5 5 5  6 6 6  7 7 7  8 8 8  9 9 9  0 0 0
+// ...
+// """
+// And canvas ← canvas_from_ppm(ppm)
+// And pattern ← uv_image(canvas)
+// When color ← uv_pattern_at(pattern, <u>, <v>)
+// Then color = <expected>
+func TestUVImagePattern(t *testing.T) {
+	var ppm strings.Builder
+	ppm.WriteString("P3\n10 10\n10\n")
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 10; x++ {
+			v := (x + y) % 10
+			ppm.WriteString(strings.Repeat(string(rune('0'+v))+" ", 3))
+		}
+		ppm.WriteString("\n")
+	}
+
+	c, err := CanvasFromPPM(strings.NewReader(ppm.String()))
+	assert.NoError(t, err)
+	p := NewUVImagePattern(c)
+
+	tests := []struct {
+		u, v     float64
+		expected *Color
+	}{
+		{0, 0, NewColor(0.9, 0.9, 0.9, 1)},
+		{0.3, 0, NewColor(0.2, 0.2, 0.2, 1)},
+		{0.6, 0.3, NewColor(0.1, 0.1, 0.1, 1)},
+		{1, 1, NewColor(0.9, 0.9, 0.9, 1)},
+	}
+
+	for _, tt := range tests {
+		assert.True(t, tt.expected.Equals(p.UVPatternAt(tt.u, tt.v)), "u %v v %v", tt.u, tt.v)
+	}
+}
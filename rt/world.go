@@ -0,0 +1,135 @@
+package rt
+
+import "math"
+
+// DEFAULT_RECURSION_DEPTH is how many times a ray may bounce between
+// reflective surfaces before giving up
+const DEFAULT_RECURSION_DEPTH = 5
+
+type World struct {
+	Objects  []Shape
+	Lights   []*PointLight
+	MaxDepth int
+}
+
+func NewWorld() *World {
+	return &World{
+		Objects:  []Shape{},
+		Lights:   []*PointLight{},
+		MaxDepth: DEFAULT_RECURSION_DEPTH,
+	}
+}
+
+func (w *World) AddObject(s ...Shape) {
+	w.Objects = append(w.Objects, s...)
+}
+
+func (w *World) AddLight(l ...*PointLight) {
+	w.Lights = append(w.Lights, l...)
+}
+
+// Intersect returns the intersections of every object in the world, sorted
+func (w *World) Intersect(r *Ray) Intersections {
+	xs := Intersections{}
+	for _, o := range w.Objects {
+		xs = append(xs, Intersect(o, r)...)
+	}
+	xs.Sort()
+	return xs
+}
+
+// ShadeHit returns the color at the intersection, summing every light and
+// adding any reflection. remaining is how many more bounces are allowed.
+func (w *World) ShadeHit(c *Computations, remaining int) *Color {
+	res := NewColor(0, 0, 0, 1)
+	for _, l := range w.Lights {
+		shadowed := w.IsShadowed(c.OverPoint, l)
+		res = res.Add(Lighting(c.Object.Material(), c.Object, l, c.OverPoint, c.EyeV, c.NormalV, shadowed))
+	}
+
+	reflected := w.ReflectedColor(c, remaining)
+	refracted := w.RefractedColor(c, remaining)
+
+	// Transparent and reflective surfaces mix the two by the Fresnel effect
+	m := c.Object.Material()
+	if m.Reflective > 0 && m.Transparency > 0 {
+		r := c.Schlick()
+		res = res.Add(reflected.Multi(r)).Add(refracted.Multi(1 - r))
+		res.W = 1
+		return res
+	}
+
+	return res.Add(reflected).Add(refracted)
+}
+
+// ReflectedColor returns the color mirrored by the hit, black if the
+// surface isn't reflective or the ray has run out of bounces
+func (w *World) ReflectedColor(c *Computations, remaining int) *Color {
+	m := c.Object.Material()
+	if remaining <= 0 || m.Reflective == 0 {
+		return NewColor(0, 0, 0, 1)
+	}
+
+	r := NewRay(c.OverPoint, c.ReflectV)
+	res := w.ColorAt(r, remaining-1).Multi(m.Reflective)
+	res.W = 1
+
+	return res
+}
+
+// RefractedColor returns the color seen through the hit, black if the
+// surface is opaque, the ray is totally internally reflected or it has
+// run out of bounces
+func (w *World) RefractedColor(c *Computations, remaining int) *Color {
+	m := c.Object.Material()
+	if remaining <= 0 || m.Transparency == 0 {
+		return NewColor(0, 0, 0, 1)
+	}
+
+	// Snell's law, finding the angle of the refracted ray
+	ratio := c.N1 / c.N2
+	cosi := c.EyeV.Dot(c.NormalV)
+	sin2t := ratio * ratio * (1 - cosi*cosi)
+	if sin2t > 1 {
+		return NewColor(0, 0, 0, 1)
+	}
+
+	cost := math.Sqrt(1 - sin2t)
+	d := c.NormalV.Multi(ratio*cosi - cost).Sub(c.EyeV.Multi(ratio))
+	d.W = 0
+
+	r := NewRay(c.UnderPoint, d)
+	res := w.ColorAt(r, remaining-1).Multi(m.Transparency)
+	res.W = 1
+
+	return res
+}
+
+// IsShadowed checks if anything that casts a shadow sits between the point
+// and the light. Hits are always on primitives, so any group or CSG they
+// are part of is checked too.
+func (w *World) IsShadowed(p *Point, l *PointLight) bool {
+	v := l.Position.Sub(p)
+	v.W = 0
+
+	dist := v.Mag()
+	r := NewRay(p, v.Norm())
+
+	for _, i := range w.Intersect(r) {
+		if i.T >= 0 && i.T < dist && CastsShadow(i.Object) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ColorAt returns the color seen along the ray, black if nothing is hit
+func (w *World) ColorAt(r *Ray, remaining int) *Color {
+	xs := w.Intersect(r)
+	h := xs.Hit()
+	if h == nil {
+		return NewColor(0, 0, 0, 1)
+	}
+	return w.ShadeHit(PrepareComputations(h, r, xs), remaining)
+}
@@ -0,0 +1,577 @@
+package rt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newDefaultWorld builds the two concentric spheres lit from the upper left
+// used throughout the book's world scenarios
+func newDefaultWorld() *World {
+	w := NewWorld()
+
+	s1 := NewSphere()
+	m := NewMaterial()
+	m.Color = NewColor(0.8, 1.0, 0.6, 1)
+	m.Diffuse = 0.7
+	m.Specular = 0.2
+	s1.SetMaterial(m)
+
+	s2 := NewSphere()
+	s2.SetTransform(NewTransform().Scale(0.5, 0.5, 0.5))
+
+	w.AddObject(s1, s2)
+	w.AddLight(NewPointLight(NewPoint(-10, 10, -10), NewColor(1, 1, 1, 1)))
+
+	return w
+}
+
+// Scenario: Creating a world
+// Given w ← world()
+// Then w contains no objects
+// And w has no light source
+func TestWorldNew(t *testing.T) {
+	w := NewWorld()
+
+	assert.Len(t, w.Objects, 0)
+	assert.Len(t, w.Lights, 0)
+}
+
+// Scenario: Intersect a world with a ray
+// Given w ← default_world()
+// And r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// When xs ← intersect_world(w, r)
+// Then xs.count = 4
+// And xs[0].t = 4
+// And xs[1].t = 4.5
+// And xs[2].t = 5.5
+// And xs[3].t = 6
+func TestWorldIntersect(t *testing.T) {
+	w := newDefaultWorld()
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+
+	xs := w.Intersect(r)
+
+	assert.Len(t, xs, 4)
+	assert.True(t, Equal(4, xs[0].T))
+	assert.True(t, Equal(4.5, xs[1].T))
+	assert.True(t, Equal(5.5, xs[2].T))
+	assert.True(t, Equal(6, xs[3].T))
+}
+
+// Scenario: Shading an intersection
+// Given w ← default_world()
+// And r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And shape ← the first object in w
+// And i ← intersection(4, shape)
+// When comps ← prepare_computations(i, r)
+// And c ← shade_hit(w, comps)
+// Then c = color(0.38066, 0.47583, 0.2855)
+func TestWorldShadeHit(t *testing.T) {
+	w := newDefaultWorld()
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	i := NewIntersection(4, w.Objects[0])
+
+	c := w.ShadeHit(PrepareComputations(i, r, NewIntersections(i)), DEFAULT_RECURSION_DEPTH)
+
+	assert.InDelta(t, 0.38066, c.X, 0.00001)
+	assert.InDelta(t, 0.47583, c.Y, 0.00001)
+	assert.InDelta(t, 0.2855, c.Z, 0.00001)
+}
+
+// Scenario: Shading an intersection from the inside
+// Given w ← default_world()
+// And w.light ← point_light(point(0, 0.25, 0), color(1, 1, 1))
+// And r ← ray(point(0, 0, 0), vector(0, 0, 1))
+// And shape ← the second object in w
+// And i ← intersection(0.5, shape)
+// When comps ← prepare_computations(i, r)
+// And c ← shade_hit(w, comps)
+// Then c = color(0.90498, 0.90498, 0.90498)
+func TestWorldShadeHitInside(t *testing.T) {
+	w := newDefaultWorld()
+	w.Lights = []*PointLight{NewPointLight(NewPoint(0, 0.25, 0), NewColor(1, 1, 1, 1))}
+	r := NewRay(NewPoint(0, 0, 0), NewVector(0, 0, 1))
+	i := NewIntersection(0.5, w.Objects[1])
+
+	c := w.ShadeHit(PrepareComputations(i, r, NewIntersections(i)), DEFAULT_RECURSION_DEPTH)
+
+	assert.InDelta(t, 0.90498, c.X, 0.00001)
+	assert.InDelta(t, 0.90498, c.Y, 0.00001)
+	assert.InDelta(t, 0.90498, c.Z, 0.00001)
+}
+
+// Each light contributes its own shading to the hit
+func TestWorldShadeHitMultipleLights(t *testing.T) {
+	w := newDefaultWorld()
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	i := NewIntersection(4, w.Objects[0])
+	c1 := w.ShadeHit(PrepareComputations(i, r, NewIntersections(i)), DEFAULT_RECURSION_DEPTH)
+
+	w.AddLight(NewPointLight(NewPoint(-10, 10, -10), NewColor(1, 1, 1, 1)))
+	c2 := w.ShadeHit(PrepareComputations(i, r, NewIntersections(i)), DEFAULT_RECURSION_DEPTH)
+
+	assert.InDelta(t, c1.X*2, c2.X, 0.00001)
+	assert.InDelta(t, c1.Y*2, c2.Y, 0.00001)
+	assert.InDelta(t, c1.Z*2, c2.Z, 0.00001)
+}
+
+// Scenario: The color when a ray misses
+// Given w ← default_world()
+// And r ← ray(point(0, 0, -5), vector(0, 1, 0))
+// When c ← color_at(w, r)
+// Then c = color(0, 0, 0)
+func TestWorldColorAtMiss(t *testing.T) {
+	w := newDefaultWorld()
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 1, 0))
+
+	c := w.ColorAt(r, DEFAULT_RECURSION_DEPTH)
+
+	assert.True(t, NewColor(0, 0, 0, 1).Equals(c))
+}
+
+// Scenario: The color when a ray hits
+// Given w ← default_world()
+// And r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// When c ← color_at(w, r)
+// Then c = color(0.38066, 0.47583, 0.2855)
+func TestWorldColorAtHit(t *testing.T) {
+	w := newDefaultWorld()
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+
+	c := w.ColorAt(r, DEFAULT_RECURSION_DEPTH)
+
+	assert.InDelta(t, 0.38066, c.X, 0.00001)
+	assert.InDelta(t, 0.47583, c.Y, 0.00001)
+	assert.InDelta(t, 0.2855, c.Z, 0.00001)
+}
+
+// Scenario: The color with an intersection behind the ray
+// Given w ← default_world()
+// And outer ← the first object in w
+// And outer.material.ambient ← 1
+// And inner ← the second object in w
+// And inner.material.ambient ← 1
+// And r ← ray(point(0, 0, 0.75), vector(0, 0, -1))
+// When c ← color_at(w, r)
+// Then c = inner.material.color
+func TestWorldColorAtBehindRay(t *testing.T) {
+	w := newDefaultWorld()
+	outer := w.Objects[0]
+	outer.Material().Ambient = 1
+	inner := w.Objects[1]
+	inner.Material().Ambient = 1
+	r := NewRay(NewPoint(0, 0, 0.75), NewVector(0, 0, -1))
+
+	c := w.ColorAt(r, DEFAULT_RECURSION_DEPTH)
+
+	assert.True(t, inner.Material().Color.Equals(c))
+}
+
+// Scenario: There is no shadow when nothing is collinear with point and light
+// Given w ← default_world()
+// And p ← point(0, 10, 0)
+// Then is_shadowed(w, p) is false
+
+// Scenario: The shadow when an object is between the point and the light
+// Given w ← default_world()
+// And p ← point(10, -10, 10)
+// Then is_shadowed(w, p) is true
+
+// Scenario: There is no shadow when an object is behind the light
+// Given w ← default_world()
+// And p ← point(-20, 20, -20)
+// Then is_shadowed(w, p) is false
+
+// Scenario: There is no shadow when an object is behind the point
+// Given w ← default_world()
+// And p ← point(-2, 2, -2)
+// Then is_shadowed(w, p) is false
+func TestWorldIsShadowed(t *testing.T) {
+	w := newDefaultWorld()
+	l := w.Lights[0]
+
+	assert.False(t, w.IsShadowed(NewPoint(0, 10, 0), l))
+	assert.True(t, w.IsShadowed(NewPoint(10, -10, 10), l))
+	assert.False(t, w.IsShadowed(NewPoint(-20, 20, -20), l))
+	assert.False(t, w.IsShadowed(NewPoint(-2, 2, -2), l))
+}
+
+// Shapes that opt out of casting shadows never block the light
+func TestWorldIsShadowedNoCastShadow(t *testing.T) {
+	w := newDefaultWorld()
+	l := w.Lights[0]
+
+	for _, o := range w.Objects {
+		o.SetCastsShadow(false)
+	}
+
+	assert.False(t, w.IsShadowed(NewPoint(10, -10, 10), l))
+}
+
+// Opting out on a group or CSG covers every shape inside it
+func TestWorldIsShadowedContainerNoCastShadow(t *testing.T) {
+	w := newDefaultWorld()
+	l := w.Lights[0]
+	p := NewPoint(10, -10, 10)
+
+	g := NewGroup()
+	g.AddChild(w.Objects...)
+	w.Objects = []Shape{g}
+	assert.True(t, w.IsShadowed(p, l))
+
+	g.SetCastsShadow(false)
+	assert.False(t, w.IsShadowed(p, l))
+
+	c := NewCSG(CSG_UNION, g, NewSphere())
+	g.SetCastsShadow(true)
+	c.SetCastsShadow(false)
+	w.Objects = []Shape{c}
+	assert.False(t, w.IsShadowed(p, l))
+}
+
+// Scenario: shade_hit() is given an intersection in shadow
+// Given w ← world()
+// And w.light ← point_light(point(0, 0, -10), color(1, 1, 1))
+// And s1 ← sphere()
+// And s1 is added to w
+// And s2 ← sphere() with:
+// | transform | translation(0, 0, 10) |
+// And s2 is added to w
+// And r ← ray(point(0, 0, 5), vector(0, 0, 1))
+// And i ← intersection(4, s2)
+// When comps ← prepare_computations(i, r)
+// And c ← shade_hit(w, comps)
+// Then c = color(0.1, 0.1, 0.1)
+func TestWorldShadeHitInShadow(t *testing.T) {
+	w := NewWorld()
+	w.AddLight(NewPointLight(NewPoint(0, 0, -10), NewColor(1, 1, 1, 1)))
+	s1 := NewSphere()
+	s2 := NewSphere()
+	s2.SetTransform(NewTransform().Translate(0, 0, 10))
+	w.AddObject(s1, s2)
+	r := NewRay(NewPoint(0, 0, 5), NewVector(0, 0, 1))
+	i := NewIntersection(4, s2)
+
+	c := w.ShadeHit(PrepareComputations(i, r, NewIntersections(i)), DEFAULT_RECURSION_DEPTH)
+
+	assert.True(t, NewColor(0.1, 0.1, 0.1, 1).Equals(c))
+}
+
+// Scenario: The reflected color for a nonreflective material
+// Given w ← default_world()
+// And r ← ray(point(0, 0, 0), vector(0, 0, 1))
+// And shape ← the second object in w
+// And shape.material.ambient ← 1
+// And i ← intersection(1, shape)
+// When comps ← prepare_computations(i, r)
+// And color ← reflected_color(w, comps)
+// Then color = color(0, 0, 0)
+func TestWorldReflectedColorNonReflective(t *testing.T) {
+	w := newDefaultWorld()
+	r := NewRay(NewPoint(0, 0, 0), NewVector(0, 0, 1))
+	s := w.Objects[1]
+	s.Material().Ambient = 1
+	i := NewIntersection(1, s)
+
+	c := w.ReflectedColor(PrepareComputations(i, r, NewIntersections(i)), DEFAULT_RECURSION_DEPTH)
+
+	assert.True(t, NewColor(0, 0, 0, 1).Equals(c))
+}
+
+// newReflectiveFloor adds the half reflective plane used by the
+// reflection scenarios below the default world's spheres
+func newReflectiveFloor(w *World) *Plane {
+	p := NewPlane()
+	p.Material().Reflective = 0.5
+	p.SetTransform(NewTransform().Translate(0, -1, 0))
+	w.AddObject(p)
+	return p
+}
+
+// Scenario: The reflected color for a reflective material
+// Given w ← default_world()
+// And shape ← plane() with:
+// | material.reflective | 0.5 |
+// | transform | translation(0, -1, 0) |
+// And shape is added to w
+// And r ← ray(point(0, 0, -3), vector(0, -√2/2, √2/2))
+// And i ← intersection(√2, shape)
+// When comps ← prepare_computations(i, r)
+// And color ← reflected_color(w, comps)
+// Then color = color(0.19032, 0.2379, 0.14274)
+func TestWorldReflectedColor(t *testing.T) {
+	w := newDefaultWorld()
+	p := newReflectiveFloor(w)
+	r := NewRay(NewPoint(0, 0, -3), NewVector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	i := NewIntersection(math.Sqrt2, p)
+
+	c := w.ReflectedColor(PrepareComputations(i, r, NewIntersections(i)), DEFAULT_RECURSION_DEPTH)
+
+	assert.InDelta(t, 0.19032, c.X, 0.0001)
+	assert.InDelta(t, 0.2379, c.Y, 0.0001)
+	assert.InDelta(t, 0.14274, c.Z, 0.0001)
+}
+
+// Scenario: shade_hit() with a reflective material
+// Given w ← default_world()
+// And shape ← plane() with:
+// | material.reflective | 0.5 |
+// | transform | translation(0, -1, 0) |
+// And shape is added to w
+// And r ← ray(point(0, 0, -3), vector(0, -√2/2, √2/2))
+// And i ← intersection(√2, shape)
+// When comps ← prepare_computations(i, r)
+// And color ← shade_hit(w, comps)
+// Then color = color(0.87677, 0.92436, 0.82918)
+func TestWorldShadeHitReflective(t *testing.T) {
+	w := newDefaultWorld()
+	p := newReflectiveFloor(w)
+	r := NewRay(NewPoint(0, 0, -3), NewVector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	i := NewIntersection(math.Sqrt2, p)
+
+	c := w.ShadeHit(PrepareComputations(i, r, NewIntersections(i)), DEFAULT_RECURSION_DEPTH)
+
+	assert.InDelta(t, 0.87677, c.X, 0.0001)
+	assert.InDelta(t, 0.92436, c.Y, 0.0001)
+	assert.InDelta(t, 0.82918, c.Z, 0.0001)
+}
+
+// Scenario: color_at() with mutually reflective surfaces
+// Given w ← world()
+// And w.light ← point_light(point(0, 0, 0), color(1, 1, 1))
+// And lower ← plane() with:
+// | material.reflective | 1 |
+// | transform | translation(0, -1, 0) |
+// And lower is added to w
+// And upper ← plane() with:
+// | material.reflective | 1 |
+// | transform | translation(0, 1, 0) |
+// And upper is added to w
+// And r ← ray(point(0, 0, 0), vector(0, 1, 0))
+// Then color_at(w, r) should terminate successfully
+func TestWorldColorAtMutuallyReflective(t *testing.T) {
+	w := NewWorld()
+	w.AddLight(NewPointLight(NewPoint(0, 0, 0), NewColor(1, 1, 1, 1)))
+
+	lower := NewPlane()
+	lower.Material().Reflective = 1
+	lower.SetTransform(NewTransform().Translate(0, -1, 0))
+
+	upper := NewPlane()
+	upper.Material().Reflective = 1
+	upper.SetTransform(NewTransform().Translate(0, 1, 0))
+
+	w.AddObject(lower, upper)
+	r := NewRay(NewPoint(0, 0, 0), NewVector(0, 1, 0))
+
+	assert.NotNil(t, w.ColorAt(r, w.MaxDepth))
+}
+
+// Scenario: The reflected color at the maximum recursive depth
+// Given w ← default_world()
+// And shape ← plane() with:
+// | material.reflective | 0.5 |
+// | transform | translation(0, -1, 0) |
+// And shape is added to w
+// And r ← ray(point(0, 0, -3), vector(0, -√2/2, √2/2))
+// And i ← intersection(√2, shape)
+// When comps ← prepare_computations(i, r)
+// And color ← reflected_color(w, comps, 0)
+// Then color = color(0, 0, 0)
+func TestWorldReflectedColorMaxDepth(t *testing.T) {
+	w := newDefaultWorld()
+	p := newReflectiveFloor(w)
+	r := NewRay(NewPoint(0, 0, -3), NewVector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	i := NewIntersection(math.Sqrt2, p)
+
+	c := w.ReflectedColor(PrepareComputations(i, r, NewIntersections(i)), 0)
+
+	assert.True(t, NewColor(0, 0, 0, 1).Equals(c))
+}
+
+// Scenario: The refracted color with an opaque surface
+// Given w ← default_world()
+// And shape ← the first object in w
+// And r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And xs ← intersections(4:shape, 6:shape)
+// When comps ← prepare_computations(xs[0], r, xs)
+// And c ← refracted_color(w, comps, 5)
+// Then c = color(0, 0, 0)
+func TestWorldRefractedColorOpaque(t *testing.T) {
+	w := newDefaultWorld()
+	s := w.Objects[0]
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	xs := NewIntersections(NewIntersection(4, s), NewIntersection(6, s))
+
+	c := w.RefractedColor(PrepareComputations(xs[0], r, xs), 5)
+
+	assert.True(t, NewColor(0, 0, 0, 1).Equals(c))
+}
+
+// Scenario: The refracted color at the maximum recursive depth
+// Given w ← default_world()
+// And shape ← the first object in w
+// And shape has:
+// | material.transparency | 1.0 |
+// | material.refractive_index | 1.5 |
+// And r ← ray(point(0, 0, -5), vector(0, 0, 1))
+// And xs ← intersections(4:shape, 6:shape)
+// When comps ← prepare_computations(xs[0], r, xs)
+// And c ← refracted_color(w, comps, 0)
+// Then c = color(0, 0, 0)
+func TestWorldRefractedColorMaxDepth(t *testing.T) {
+	w := newDefaultWorld()
+	s := w.Objects[0]
+	s.Material().Transparency = 1.0
+	s.Material().RefractiveIndex = 1.5
+	r := NewRay(NewPoint(0, 0, -5), NewVector(0, 0, 1))
+	xs := NewIntersections(NewIntersection(4, s), NewIntersection(6, s))
+
+	c := w.RefractedColor(PrepareComputations(xs[0], r, xs), 0)
+
+	assert.True(t, NewColor(0, 0, 0, 1).Equals(c))
+}
+
+// Scenario: The refracted color under total internal reflection
+// Given w ← default_world()
+// And shape ← the first object in w
+// And shape has:
+// | material.transparency | 1.0 |
+// | material.refractive_index | 1.5 |
+// And r ← ray(point(0, 0, √2/2), vector(0, 1, 0))
+// And xs ← intersections(-√2/2:shape, √2/2:shape)
+// When comps ← prepare_computations(xs[1], r, xs)
+// And c ← refracted_color(w, comps, 5)
+// Then c = color(0, 0, 0)
+func TestWorldRefractedColorTotalInternalReflection(t *testing.T) {
+	w := newDefaultWorld()
+	s := w.Objects[0]
+	s.Material().Transparency = 1.0
+	s.Material().RefractiveIndex = 1.5
+	r := NewRay(NewPoint(0, 0, math.Sqrt2/2), NewVector(0, 1, 0))
+	xs := NewIntersections(NewIntersection(-math.Sqrt2/2, s), NewIntersection(math.Sqrt2/2, s))
+
+	c := w.RefractedColor(PrepareComputations(xs[1], r, xs), 5)
+
+	assert.True(t, NewColor(0, 0, 0, 1).Equals(c))
+}
+
+// newTransparentFloor adds the half transparent glass plane and the red
+// ball beneath it used by the refraction shading scenarios
+func newTransparentFloor(w *World) *Plane {
+	floor := NewPlane()
+	floor.SetTransform(NewTransform().Translate(0, -1, 0))
+	floor.Material().Transparency = 0.5
+	floor.Material().RefractiveIndex = 1.5
+
+	ball := NewSphere()
+	ball.Material().Color = NewColor(1, 0, 0, 1)
+	ball.Material().Ambient = 0.5
+	ball.SetTransform(NewTransform().Translate(0, -3.5, -0.5))
+
+	w.AddObject(floor, ball)
+	return floor
+}
+
+// Scenario: shade_hit() with a transparent material
+// Given w ← default_world()
+// And floor ← plane() with:
+// | transform | translation(0, -1, 0) |
+// | material.transparency | 0.5 |
+// | material.refractive_index | 1.5 |
+// And floor is added to w
+// And ball ← sphere() with:
+// | material.color | (1, 0, 0) |
+// | material.ambient | 0.5 |
+// | transform | translation(0, -3.5, -0.5) |
+// And ball is added to w
+// And r ← ray(point(0, 0, -3), vector(0, -√2/2, √2/2))
+// And xs ← intersections(√2:floor)
+// When comps ← prepare_computations(xs[0], r, xs)
+// And color ← shade_hit(w, comps, 5)
+// Then color = color(0.93642, 0.68642, 0.68642)
+func TestWorldShadeHitTransparent(t *testing.T) {
+	w := newDefaultWorld()
+	floor := newTransparentFloor(w)
+	r := NewRay(NewPoint(0, 0, -3), NewVector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	xs := NewIntersections(NewIntersection(math.Sqrt2, floor))
+
+	c := w.ShadeHit(PrepareComputations(xs[0], r, xs), 5)
+
+	assert.InDelta(t, 0.93642, c.X, 0.0001)
+	assert.InDelta(t, 0.68642, c.Y, 0.0001)
+	assert.InDelta(t, 0.68642, c.Z, 0.0001)
+}
+
+// Scenario: shade_hit() with a reflective, transparent material
+// Given w ← default_world()
+// And r ← ray(point(0, 0, -3), vector(0, -√2/2, √2/2))
+// And floor ← plane() with:
+// | transform | translation(0, -1, 0) |
+// | material.reflective | 0.5 |
+// | material.transparency | 0.5 |
+// | material.refractive_index | 1.5 |
+// And floor is added to w
+// And ball ← sphere() with:
+// | material.color | (1, 0, 0) |
+// | material.ambient | 0.5 |
+// | transform | translation(0, -3.5, -0.5) |
+// And ball is added to w
+// And xs ← intersections(√2:floor)
+// When comps ← prepare_computations(xs[0], r, xs)
+// And color ← shade_hit(w, comps, 5)
+// Then color = color(0.93391, 0.69643, 0.69243)
+func TestWorldShadeHitReflectiveTransparent(t *testing.T) {
+	w := newDefaultWorld()
+	floor := newTransparentFloor(w)
+	floor.Material().Reflective = 0.5
+	r := NewRay(NewPoint(0, 0, -3), NewVector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	xs := NewIntersections(NewIntersection(math.Sqrt2, floor))
+
+	c := w.ShadeHit(PrepareComputations(xs[0], r, xs), 5)
+
+	assert.InDelta(t, 0.93391, c.X, 0.0001)
+	assert.InDelta(t, 0.69643, c.Y, 0.0001)
+	assert.InDelta(t, 0.69243, c.Z, 0.0001)
+}
+
+// Scenario: The refracted color with a refracted ray
+// Given w ← default_world()
+// And A ← the first object in w
+// And A has:
+// | material.ambient | 1.0 |
+// | material.pattern | test_pattern() |
+// And B ← the second object in w
+// And B has:
+// | material.transparency | 1.0 |
+// | material.refractive_index | 1.5 |
+// And r ← ray(point(0, 0, 0.1), vector(0, 1, 0))
+// And xs ← intersections(-0.9899:A, -0.4899:B, 0.4899:B, 0.9899:A)
+// When comps ← prepare_computations(xs[2], r, xs)
+// And c ← refracted_color(w, comps, 5)
+// Then c = color(0, 0.99888, 0.04725)
+func TestWorldRefractedColor(t *testing.T) {
+	w := newDefaultWorld()
+	a := w.Objects[0]
+	a.Material().Ambient = 1.0
+	a.Material().Pattern = newTestPattern()
+	b := w.Objects[1]
+	b.Material().Transparency = 1.0
+	b.Material().RefractiveIndex = 1.5
+	r := NewRay(NewPoint(0, 0, 0.1), NewVector(0, 1, 0))
+	xs := NewIntersections(
+		NewIntersection(-0.9899, a),
+		NewIntersection(-0.4899, b),
+		NewIntersection(0.4899, b),
+		NewIntersection(0.9899, a),
+	)
+
+	c := w.RefractedColor(PrepareComputations(xs[2], r, xs), 5)
+
+	assert.InDelta(t, 0, c.X, 0.0001)
+	assert.InDelta(t, 0.99888, c.Y, 0.0001)
+	assert.InDelta(t, 0.04725, c.Z, 0.0001)
+}